@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Authenticator sets the credentials of a request. It is called before every attempt, so that it can refresh
+// short-lived tokens, and may use the request context to do so.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// invalidator is implemented by authenticators caching tokens which can be revoked by the server before they
+// are due to expire.
+type invalidator interface {
+	Invalidate()
+}
+
+// authenticate sets the credentials of the request, using the API key unless an Authenticator is configured.
+func (h *HTTPHandler) authenticate(req *http.Request) error {
+	if h.Authenticator == nil {
+		req.Header.Set("Authorization", fmt.Sprintf("App %s", h.APIKey))
+		return nil
+	}
+
+	return h.Authenticator.Authenticate(req)
+}
+
+// invalidateCredentials drops cached tokens rejected by the server, so that the next request gets a new one.
+func (h *HTTPHandler) invalidateCredentials(resp *http.Response) {
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		return
+	}
+	if inv, ok := h.Authenticator.(invalidator); ok {
+		inv.Invalidate()
+	}
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingAuthenticator struct {
+	calls         int
+	invalidations int
+	err           error
+}
+
+func (a *countingAuthenticator) Authenticate(req *http.Request) error {
+	a.calls++
+	req.Header.Set("Authorization", "Bearer some-token")
+	return a.err
+}
+
+func (a *countingAuthenticator) Invalidate() {
+	a.invalidations++
+}
+
+func TestAuthenticatorCalledOnEveryAttempt(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer some-token", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer serv.Close()
+
+	authenticator := countingAuthenticator{}
+	handler := HTTPHandler{
+		APIKey:        "unused",
+		Authenticator: &authenticator,
+		HTTPClient:    http.Client{},
+		BaseURL:       serv.URL,
+		RetryPolicy:   testRetryPolicy(),
+	}
+	respDetails, err := handler.DeleteRequest(context.Background(), "some/path", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, 1, authenticator.calls)
+	assert.Equal(t, 1, authenticator.invalidations)
+}
+
+func TestAuthenticatorError(t *testing.T) {
+	authenticator := countingAuthenticator{err: assert.AnError}
+	handler := HTTPHandler{Authenticator: &authenticator, HTTPClient: http.Client{}, BaseURL: "https://example.com"}
+	_, err := handler.DeleteRequest(context.Background(), "some/path", nil)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, 1, authenticator.calls)
+	assert.Zero(t, authenticator.invalidations)
+}
@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"context"
+	"sync"
+)
+
+// RunBulk calls send for each index of a bulk of n items, from at most workers goroutines at once. Values of workers
+// below 1 are treated as 1. Once ctx is done, fail is called with the context's error instead of send for the
+// indexes which were not handed to a worker yet. RunBulk returns when all the indexes are done.
+func RunBulk(ctx context.Context, n int, workers int, send func(index int), fail func(index int, err error)) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers && i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				send(index)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		if err := ctx.Err(); err != nil {
+			fail(i, err)
+			continue
+		}
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			fail(i, ctx.Err())
+		}
+	}
+	close(indexes)
+	wg.Wait()
+}
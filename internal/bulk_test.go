@@ -0,0 +1,49 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunBulk(t *testing.T) {
+	var running, maxRunning int32
+	var mu sync.Mutex
+	sent := map[int]bool{}
+
+	RunBulk(context.Background(), 10, 3, func(index int) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		mu.Lock()
+		if current > maxRunning {
+			maxRunning = current
+		}
+		sent[index] = true
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+	}, func(index int, err error) {
+		t.Errorf("index %d failed: %v", index, err)
+	})
+
+	assert.Len(t, sent, 10)
+	assert.LessOrEqual(t, maxRunning, int32(3))
+}
+
+func TestRunBulkCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var failed []int
+
+	RunBulk(ctx, 3, 0, func(index int) {
+		t.Errorf("index %d sent", index)
+	}, func(index int, err error) {
+		assert.Equal(t, context.Canceled, err)
+		failed = append(failed, index)
+	})
+
+	assert.Equal(t, []int{0, 1, 2}, failed)
+}
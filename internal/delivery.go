@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+const (
+	defaultPollInitialInterval = time.Second
+	defaultPollMaxInterval     = 30 * time.Second
+	defaultPollMultiplier      = 2
+)
+
+// PollPolicy configures how often delivery reports are polled while waiting for messages to be delivered.
+type PollPolicy struct {
+	// InitialInterval is the delay between the first and the second poll. Defaults to one second.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between polls. Zero means no cap.
+	MaxInterval time.Duration
+	// Multiplier is the factor by which the delay grows after each poll. Values below 1 are treated as 1.
+	Multiplier float64
+}
+
+// DefaultPollPolicy returns a policy polling after one second first, doubling the delay up to 30 seconds.
+func DefaultPollPolicy() PollPolicy {
+	return PollPolicy{
+		InitialInterval: defaultPollInitialInterval,
+		MaxInterval:     defaultPollMaxInterval,
+		Multiplier:      defaultPollMultiplier,
+	}
+}
+
+// interval returns the delay after the given poll, falling back to the defaults for unset fields.
+func (p PollPolicy) interval(poll int) time.Duration {
+	initial := p.InitialInterval
+	if initial <= 0 {
+		initial = defaultPollInitialInterval
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	delay := float64(initial) * math.Pow(multiplier, float64(poll-1))
+	if p.MaxInterval > 0 && delay > float64(p.MaxInterval) {
+		delay = float64(p.MaxInterval)
+	}
+
+	return time.Duration(delay)
+}
+
+// IsFinalStatus reports whether a message with the given status group will not change its status anymore.
+func IsFinalStatus(groupName string) bool {
+	switch groupName {
+	case "DELIVERED", "UNDELIVERABLE", "EXPIRED", "REJECTED":
+		return true
+	default:
+		return false
+	}
+}
+
+// DeliveryPoller polls the delivery reports of a single message and reports whether its status is final.
+type DeliveryPoller func(ctx context.Context, messageID string) (final bool, err error)
+
+// WaitForDelivery polls the given messages with backoff until all of them have a final status. Messages are polled
+// one by one, as each delivery report is returned only once by the API. It returns the context's error when the
+// context expires first, and stops at the first error returned by the poller.
+func WaitForDelivery(ctx context.Context, messageIDs []string, policy PollPolicy, poll DeliveryPoller) error {
+	pending := make([]string, 0, len(messageIDs))
+	seen := make(map[string]bool, len(messageIDs))
+	for _, messageID := range messageIDs {
+		if !seen[messageID] {
+			seen[messageID] = true
+			pending = append(pending, messageID)
+		}
+	}
+
+	for round := 1; ; round++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		remaining := pending[:0]
+		for _, messageID := range pending {
+			final, err := poll(ctx, messageID)
+			if err != nil {
+				return err
+			}
+			if !final {
+				remaining = append(remaining, messageID)
+			}
+		}
+		pending = remaining
+		if len(pending) == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(policy.interval(round))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollPolicyInterval(t *testing.T) {
+	policy := PollPolicy{InitialInterval: time.Second, MaxInterval: 5 * time.Second, Multiplier: 2}
+	assert.Equal(t, time.Second, policy.interval(1))
+	assert.Equal(t, 4*time.Second, policy.interval(3))
+	assert.Equal(t, 5*time.Second, policy.interval(4))
+	assert.Equal(t, defaultPollInitialInterval, PollPolicy{}.interval(3))
+}
+
+func TestIsFinalStatus(t *testing.T) {
+	for _, group := range []string{"DELIVERED", "UNDELIVERABLE", "EXPIRED", "REJECTED"} {
+		assert.True(t, IsFinalStatus(group), group)
+	}
+	assert.False(t, IsFinalStatus("PENDING"))
+	assert.False(t, IsFinalStatus(""))
+}
+
+func TestWaitForDelivery(t *testing.T) {
+	polls := map[string]int{}
+	err := WaitForDelivery(context.Background(), []string{"a", "b", "a"}, PollPolicy{InitialInterval: time.Millisecond},
+		func(_ context.Context, messageID string) (bool, error) {
+			polls[messageID]++
+			return messageID == "a" || polls[messageID] == 3, nil
+		})
+
+	require.NoError(t, err)
+	// Final messages are not polled again, and duplicated IDs are polled once.
+	assert.Equal(t, map[string]int{"a": 1, "b": 3}, polls)
+}
+
+func TestWaitForDeliveryErrors(t *testing.T) {
+	pollErr := errors.New("poll failed")
+	err := WaitForDelivery(context.Background(), []string{"a"}, PollPolicy{},
+		func(context.Context, string) (bool, error) {
+			return false, pollErr
+		})
+	assert.Equal(t, pollErr, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	polls := 0
+	err = WaitForDelivery(ctx, []string{"a"}, PollPolicy{InitialInterval: time.Millisecond},
+		func(context.Context, string) (bool, error) {
+			polls++
+			return false, nil
+		})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Greater(t, polls, 1)
+}
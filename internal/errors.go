@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+const requestIDHeader = "X-Request-Id"
+
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// APIError describes a non-2xx response returned by the Infobip API.
+type APIError struct {
+	StatusCode       int
+	MessageID        string
+	Text             string
+	ValidationErrors map[string]interface{}
+	Body             []byte
+	RequestID        string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("infobip: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.MessageID != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.MessageID)
+	}
+	if e.Text != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Text)
+	}
+
+	return msg
+}
+
+// Is makes errors.Is match an APIError against the sentinel error corresponding to its status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	default:
+		return false
+	}
+}
+
+// apiError returns an APIError for an unsuccessful response when the handler is configured to return them.
+func (h *HTTPHandler) apiError(resp *http.Response, body []byte, exception models.ServiceException) error {
+	if !h.ReturnAPIErrors {
+		return nil
+	}
+
+	return &APIError{
+		StatusCode:       resp.StatusCode,
+		MessageID:        exception.MessageID,
+		Text:             exception.Text,
+		ValidationErrors: exception.ValidationErrors,
+		Body:             body,
+		RequestID:        resp.Header.Get(requestIDHeader),
+	}
+}
+
+// ResponseError returns an APIError for an unsuccessful response, regardless of the configuration of the handler,
+// for helpers which need to stop on such responses.
+func ResponseError(respDetails models.ResponseDetails) error {
+	if isSuccessStatus(respDetails.HTTPResponse.StatusCode) {
+		return nil
+	}
+	exception := respDetails.ErrorResponse.RequestError.ServiceException
+
+	return &APIError{
+		StatusCode:       respDetails.HTTPResponse.StatusCode,
+		MessageID:        exception.MessageID,
+		Text:             exception.Text,
+		ValidationErrors: exception.ValidationErrors,
+		RequestID:        respDetails.HTTPResponse.Header.Get(requestIDHeader),
+	}
+}
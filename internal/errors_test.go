@@ -0,0 +1,176 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorReturned(t *testing.T) {
+	rawJSONResp := []byte(`{
+		"requestError": {
+			"serviceException": {
+				"messageId": "BAD_REQUEST",
+				"text": "Bad request",
+				"validationErrors": {"to": ["must not be empty"]}
+			}
+		}
+	}`)
+	msg := models.WATextMsg{
+		MsgCommon: models.GenerateTestMsgCommon(),
+		Content:   models.TextContent{Text: "hello world"},
+	}
+	putMsg := models.RescheduleSMSRequest{SendAt: "2022-01-01T00:00:00Z"}
+
+	tests := []struct {
+		scenario string
+		call     func(handler HTTPHandler) (models.ResponseDetails, error)
+	}{
+		{
+			scenario: "GET",
+			call: func(handler HTTPHandler) (models.ResponseDetails, error) {
+				return handler.GetRequest(context.Background(), &exampleResp{}, "some/path", nil)
+			},
+		},
+		{
+			scenario: "POST",
+			call: func(handler HTTPHandler) (models.ResponseDetails, error) {
+				return handler.PostJSONReq(context.Background(), &msg, &models.SendWAMsgResponse{}, "some/path")
+			},
+		},
+		{
+			scenario: "POST no body",
+			call: func(handler HTTPHandler) (models.ResponseDetails, error) {
+				return handler.PostNoBodyReq(context.Background(), &exampleResp{}, "some/path")
+			},
+		},
+		{
+			scenario: "PUT",
+			call: func(handler HTTPHandler) (models.ResponseDetails, error) {
+				return handler.PutJSONReq(context.Background(), &putMsg, &exampleResp{}, "some/path", nil)
+			},
+		},
+		{
+			scenario: "DELETE",
+			call: func(handler HTTPHandler) (models.ResponseDetails, error) {
+				return handler.DeleteRequest(context.Background(), "some/path", nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "some-request-id")
+				w.WriteHeader(http.StatusBadRequest)
+				_, servErr := w.Write(rawJSONResp)
+				assert.Nil(t, servErr)
+			}))
+			defer serv.Close()
+
+			handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}
+			respDetails, err := tc.call(handler)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, respDetails.HTTPResponse.StatusCode)
+
+			handler.ReturnAPIErrors = true
+			respDetails, err = tc.call(handler)
+			require.Error(t, err)
+			assert.Equal(t, http.StatusBadRequest, respDetails.HTTPResponse.StatusCode)
+			assert.Equal(t, "BAD_REQUEST", respDetails.ErrorResponse.RequestError.ServiceException.MessageID)
+			assert.True(t, errors.Is(err, ErrBadRequest))
+			assert.False(t, errors.Is(err, ErrUnauthorized))
+
+			var apiErr *APIError
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+			assert.Equal(t, "BAD_REQUEST", apiErr.MessageID)
+			assert.Equal(t, "Bad request", apiErr.Text)
+			assert.Contains(t, apiErr.ValidationErrors, "to")
+			assert.Equal(t, rawJSONResp, apiErr.Body)
+			assert.Equal(t, "some-request-id", apiErr.RequestID)
+		})
+	}
+}
+
+func TestAPIErrorNotReturnedOnSuccess(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, ReturnAPIErrors: true}
+	respDetails, err := handler.DeleteRequest(context.Background(), "some/path", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestAPIErrorMMS(t *testing.T) {
+	rawJSONResp := []byte(`{
+		"bulkId": "",
+		"messages": [],
+		"errorMessage": "Invalid destination address"
+	}`)
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, servErr := w.Write(rawJSONResp)
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	image, err := os.Open("testdata/image.png")
+	require.NoError(t, err)
+	msg := models.MMSMsg{Head: models.MMSHead{From: "38598765432", To: "38591234567"}, Media: image}
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, ReturnAPIErrors: true}
+	respResource := models.SendMMSResponse{}
+	respDetails, err := handler.PostMultipartReq(context.Background(), &msg, &respResource, "some/path")
+
+	require.Error(t, err)
+	assert.Equal(t, "Invalid destination address", respResource.ErrorMessage)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Invalid destination address", apiErr.Text)
+	assert.True(t, errors.Is(err, ErrBadRequest))
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		expected   error
+	}{
+		{statusCode: http.StatusBadRequest, expected: ErrBadRequest},
+		{statusCode: http.StatusUnauthorized, expected: ErrUnauthorized},
+		{statusCode: http.StatusForbidden, expected: ErrForbidden},
+		{statusCode: http.StatusNotFound, expected: ErrNotFound},
+		{statusCode: http.StatusTooManyRequests, expected: ErrRateLimited},
+		{statusCode: http.StatusInternalServerError, expected: ErrServer},
+		{statusCode: http.StatusServiceUnavailable, expected: ErrServer},
+	}
+	sentinels := []error{ErrBadRequest, ErrUnauthorized, ErrForbidden, ErrNotFound, ErrRateLimited, ErrServer}
+
+	for _, tc := range tests {
+		t.Run(http.StatusText(tc.statusCode), func(t *testing.T) {
+			err := &APIError{StatusCode: tc.statusCode}
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == tc.expected, errors.Is(err, sentinel))
+			}
+		})
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	err := &APIError{StatusCode: http.StatusUnauthorized, MessageID: "UNAUTHORIZED", Text: "Invalid login details"}
+	assert.Equal(t, "infobip: 401 Unauthorized: UNAUTHORIZED: Invalid login details", err.Error())
+
+	err = &APIError{StatusCode: http.StatusBadGateway}
+	assert.Equal(t, "infobip: 502 Bad Gateway", err.Error())
+}
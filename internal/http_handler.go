@@ -7,17 +7,32 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"runtime"
+	"time"
 
 	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
 )
 
 type HTTPHandler struct {
-	APIKey     string
-	BaseURL    string
-	HTTPClient http.Client
+	APIKey string
+	// Authenticator, when set, authenticates requests instead of the APIKey.
+	Authenticator Authenticator
+	BaseURL       string
+	HTTPClient    http.Client
+	RetryPolicy   RetryPolicy
+	// RateLimiter, when set, is waited on before every attempt. It is shared by the handlers of all channels.
+	RateLimiter *RateLimiter
+	// Middlewares wrap the HTTP client for every attempt of every request, the first one being the outermost.
+	Middlewares []Middleware
+	// Instrumentation, when set, is notified of the start and end of every operation.
+	Instrumentation Instrumentation
+	// Logger, when set, receives debug logs of every attempt, with secrets redacted.
+	Logger Logger
+	// ReturnAPIErrors makes non-2xx responses return an *APIError in addition to the populated ResponseDetails.
+	ReturnAPIErrors bool
 }
 
 type QueryParameter struct {
@@ -37,19 +52,30 @@ func (h *HTTPHandler) createReq(
 		return nil, err
 	}
 	req.Header = h.generateCommonHeaders()
-	req.URL.RawQuery = generateQueryParams(queryParams)
+	if query := generateQueryParams(queryParams); query != "" {
+		req.URL.RawQuery = query
+	}
+	if err = h.authenticate(req); err != nil {
+		return nil, err
+	}
 	return req, nil
 }
 
 func (h *HTTPHandler) executeReq(
 	req *http.Request,
+	respWriter io.Writer,
 ) (resp *http.Response, respBody []byte, err error) {
-	resp, err = h.HTTPClient.Do(req)
+	resp, err = h.doer().Do(req)
 	if err != nil {
 		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
+	if respWriter != nil && isSuccessStatus(resp.StatusCode) {
+		_, err = io.Copy(respWriter, resp.Body)
+		return resp, nil, err
+	}
+
 	parsedBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		parsedBody = nil
@@ -58,18 +84,106 @@ func (h *HTTPHandler) executeReq(
 	return resp, parsedBody, err
 }
 
+// request describes a request sent by sendReq.
+type request struct {
+	method string
+	path   string
+	// body is replayed from the same bytes on every attempt.
+	body []byte
+	// bodyStream, when set, is sent instead of body. It can only be read once, so the request is never retried.
+	bodyStream  io.Reader
+	contentType string
+	queryParams []QueryParameter
+	// respWriter, when set, receives the body of a 2xx response instead of it being buffered. Requests are never
+	// retried once it has been written to.
+	respWriter io.Writer
+}
+
+func (r request) bodyReader() io.Reader {
+	if r.bodyStream != nil {
+		return r.bodyStream
+	}
+	if r.body != nil {
+		return bytes.NewReader(r.body)
+	}
+
+	return nil
+}
+
+// sendReq builds and executes a request, retrying it according to the handler's RetryPolicy and waiting for
+// its RateLimiter before every attempt.
+// The whole operation, including retries, is reported to the handler's Instrumentation.
+func (h *HTTPHandler) sendReq(ctx context.Context, r request) (resp *http.Response, respBody []byte, err error) {
+	if h.Instrumentation == nil {
+		resp, respBody, _, err = h.sendAttempts(ctx, r) //nolint: bodyclose // closed in the method itself
+		return resp, respBody, err
+	}
+
+	ctx, span := h.startSpan(ctx, r.method, r.path)
+	start := time.Now()
+	resp, respBody, attempts, err := h.sendAttempts(ctx, r) //nolint: bodyclose // closed in the method itself
+	span.End(newOperationEnd(resp, respBody, attempts, time.Since(start), err))
+
+	return resp, respBody, err
+}
+
+func (h *HTTPHandler) sendAttempts(
+	ctx context.Context,
+	r request,
+) (resp *http.Response, respBody []byte, attempt int, err error) {
+	for attempt = 1; ; attempt++ {
+		if err = h.RateLimiter.Wait(ctx, r.path); err != nil {
+			return nil, nil, attempt, err
+		}
+
+		var req *http.Request
+		req, err = h.createReq(ctx, r.method, r.path, r.bodyReader(), r.queryParams)
+		if err != nil {
+			return nil, nil, attempt, err
+		}
+		if r.contentType != "" {
+			req.Header.Set("Content-Type", r.contentType)
+		}
+
+		start := time.Now()
+		resp, respBody, err = h.executeReq(req, r.respWriter) //nolint: bodyclose // closed in the method itself
+		if h.Logger != nil {
+			h.logAttempt(req, r.body, resp, respBody, time.Since(start), err)
+		}
+		h.pauseRateLimiter(r.path, resp)
+		h.invalidateCredentials(resp)
+		if r.bodyStream != nil || (r.respWriter != nil && resp != nil && isSuccessStatus(resp.StatusCode)) {
+			return resp, respBody, attempt, err
+		}
+		delay, retry := h.RetryPolicy.nextDelay(attempt, r.method, resp, err)
+		if !retry || !sleepCtx(ctx, delay) {
+			return resp, respBody, attempt, err
+		}
+	}
+}
+
+func isSuccessStatus(status int) bool {
+	return status >= http.StatusOK && status < http.StatusMultipleChoices
+}
+
+// pauseRateLimiter makes all requests sharing the path's rate limit wait for as long as the server asked to.
+func (h *HTTPHandler) pauseRateLimiter(reqPath string, resp *http.Response) {
+	if h.RateLimiter == nil || resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+	if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		h.RateLimiter.Pause(reqPath, delay)
+	}
+}
+
 func (h *HTTPHandler) GetRequest(
 	ctx context.Context,
 	respResource interface{},
 	reqPath string,
 	queryParams []QueryParameter,
 ) (respDetails models.ResponseDetails, err error) {
-	req, err := h.createReq(ctx, http.MethodGet, reqPath, nil, queryParams)
-	if err != nil {
-		return respDetails, err
-	}
-
-	resp, parsedBody, err := h.executeReq(req) //nolint: bodyclose // closed in the method itself
+	resp, parsedBody, err := h.sendReq( //nolint: bodyclose // closed in the method itself
+		ctx, request{method: http.MethodGet, path: reqPath, queryParams: queryParams})
 	if err != nil {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
 		return respDetails, err
@@ -80,6 +194,7 @@ func (h *HTTPHandler) GetRequest(
 		err = json.Unmarshal(parsedBody, &respResource)
 	} else {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		err = h.apiError(resp, parsedBody, respDetails.ErrorResponse.RequestError.ServiceException)
 	}
 	return respDetails, err
 }
@@ -127,6 +242,14 @@ func (h *HTTPHandler) PostNoBodyReq(
 	return h.postNoBodyRequest(ctx, respResource, reqPath)
 }
 
+// PostNoContentReq sends a POST request without a body, for operations which are answered without content.
+func (h *HTTPHandler) PostNoContentReq(
+	ctx context.Context,
+	reqPath string,
+) (respDetails models.ResponseDetails, err error) {
+	return h.noContentRequest(ctx, request{method: http.MethodPost, path: reqPath})
+}
+
 func (h *HTTPHandler) PutJSONReq(
 	ctx context.Context,
 	putResource models.Validatable,
@@ -145,6 +268,45 @@ func (h *HTTPHandler) PutJSONReq(
 	return h.putRequest(ctx, payload, respResource, reqPath, "application/json", queryParams)
 }
 
+// PutJSONNoContentReq sends a JSON payload with a PUT request, for operations which are answered without content.
+func (h *HTTPHandler) PutJSONNoContentReq(
+	ctx context.Context,
+	putResource models.Validatable,
+	reqPath string,
+) (respDetails models.ResponseDetails, err error) {
+	err = putResource.Validate()
+	if err != nil {
+		return respDetails, err
+	}
+	payload, err := putResource.Marshal()
+	if err != nil {
+		return respDetails, err
+	}
+	return h.noContentRequest(ctx, request{
+		method:      http.MethodPut,
+		path:        reqPath,
+		body:        payload.Bytes(),
+		contentType: "application/json",
+	})
+}
+
+func (h *HTTPHandler) PatchJSONReq(
+	ctx context.Context,
+	patchResource models.Validatable,
+	respResource interface{},
+	reqPath string,
+) (respDetails models.ResponseDetails, err error) {
+	err = patchResource.Validate()
+	if err != nil {
+		return respDetails, err
+	}
+	payload, err := patchResource.Marshal()
+	if err != nil {
+		return respDetails, err
+	}
+	return h.patchRequest(ctx, payload, respResource, reqPath)
+}
+
 func (h *HTTPHandler) PostMultipartReq(
 	ctx context.Context,
 	postResource models.MultipartValidatable,
@@ -169,17 +331,96 @@ func (h *HTTPHandler) PostMultipartReq(
 	)
 }
 
+// PostMultipartStreamReq streams the multipart payload while the request is being sent, without buffering it.
+// The request is never retried, since the payload can only be read once.
+func (h *HTTPHandler) PostMultipartStreamReq(
+	ctx context.Context,
+	postResource models.MultipartStreamValidatable,
+	respResource interface{},
+	reqPath string,
+) (respDetails models.ResponseDetails, err error) {
+	err = postResource.Validate()
+	if err != nil {
+		return respDetails, err
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	// Closing the reader stops the writer if the request ends before the whole payload is sent.
+	defer pipeReader.Close()
+	multipartWriter := multipart.NewWriter(pipeWriter)
+	go func() {
+		writeErr := postResource.WriteMultipart(multipartWriter)
+		if writeErr == nil {
+			writeErr = multipartWriter.Close()
+		}
+		pipeWriter.CloseWithError(writeErr)
+	}()
+
+	resp, parsedBody, err := h.sendReq(ctx, request{ //nolint: bodyclose // closed in the method itself
+		method:      http.MethodPost,
+		path:        reqPath,
+		bodyStream:  pipeReader,
+		contentType: multipartWriter.FormDataContentType(),
+	})
+	if err != nil {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		return respDetails, err
+	}
+	respDetails.HTTPResponse = *resp
+
+	if resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusOK ||
+		resp.StatusCode == http.StatusNoContent {
+		if len(parsedBody) > 0 {
+			err = json.Unmarshal(parsedBody, &respResource)
+		}
+	} else {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		err = h.apiError(resp, parsedBody, respDetails.ErrorResponse.RequestError.ServiceException)
+	}
+	return respDetails, err
+}
+
 func (h *HTTPHandler) DeleteRequest(
 	ctx context.Context,
 	reqPath string,
 	queryParams []QueryParameter,
 ) (respDetails models.ResponseDetails, err error) {
-	req, err := h.createReq(ctx, http.MethodDelete, reqPath, nil, queryParams)
+	resp, parsedBody, err := h.sendReq( //nolint: bodyclose // closed in the method itself
+		ctx, request{method: http.MethodDelete, path: reqPath, queryParams: queryParams})
+	if err != nil {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		return respDetails, err
+	}
+	respDetails.HTTPResponse = *resp
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		err = h.apiError(resp, parsedBody, respDetails.ErrorResponse.RequestError.ServiceException)
+	}
+
+	return respDetails, err
+}
+
+func (h *HTTPHandler) DeleteJSONReq(
+	ctx context.Context,
+	deleteResource models.Validatable,
+	reqPath string,
+) (respDetails models.ResponseDetails, err error) {
+	err = deleteResource.Validate()
+	if err != nil {
+		return respDetails, err
+	}
+	payload, err := deleteResource.Marshal()
 	if err != nil {
 		return respDetails, err
 	}
 
-	resp, parsedBody, err := h.executeReq(req) //nolint: bodyclose // closed in the method itself
+	resp, parsedBody, err := h.sendReq(ctx, request{ //nolint: bodyclose // closed in the method itself
+		method:      http.MethodDelete,
+		path:        reqPath,
+		body:        payload.Bytes(),
+		contentType: "application/json",
+	})
 	if err != nil {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
 		return respDetails, err
@@ -188,6 +429,52 @@ func (h *HTTPHandler) DeleteRequest(
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		err = h.apiError(resp, parsedBody, respDetails.ErrorResponse.RequestError.ServiceException)
+	}
+
+	return respDetails, err
+}
+
+// HeadRequest sends a HEAD request. The headers of the response are available in the returned ResponseDetails.
+func (h *HTTPHandler) HeadRequest(
+	ctx context.Context,
+	reqPath string,
+) (respDetails models.ResponseDetails, err error) {
+	resp, _, err := h.sendReq( //nolint: bodyclose // closed in the method itself
+		ctx, request{method: http.MethodHead, path: reqPath})
+	if err != nil {
+		return respDetails, err
+	}
+	respDetails.HTTPResponse = *resp
+
+	if resp.StatusCode != http.StatusOK {
+		err = h.apiError(resp, nil, respDetails.ErrorResponse.RequestError.ServiceException)
+	}
+
+	return respDetails, err
+}
+
+// DownloadReq sends a GET request, copying the body of a successful response into respWriter as it is received.
+// Retries stop once the response body starts being copied.
+func (h *HTTPHandler) DownloadReq(
+	ctx context.Context,
+	respWriter io.Writer,
+	reqPath string,
+) (respDetails models.ResponseDetails, err error) {
+	resp, parsedBody, err := h.sendReq( //nolint: bodyclose // closed in the method itself
+		ctx, request{method: http.MethodGet, path: reqPath, respWriter: respWriter})
+	if err != nil {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		if resp != nil {
+			respDetails.HTTPResponse = *resp
+		}
+		return respDetails, err
+	}
+	respDetails.HTTPResponse = *resp
+
+	if !isSuccessStatus(resp.StatusCode) {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		err = h.apiError(resp, parsedBody, respDetails.ErrorResponse.RequestError.ServiceException)
 	}
 
 	return respDetails, err
@@ -201,13 +488,13 @@ func (h *HTTPHandler) postRequest(
 	contentType string,
 	queryParams []QueryParameter,
 ) (respDetails models.ResponseDetails, err error) {
-	req, err := h.createReq(ctx, http.MethodPost, reqPath, payload, queryParams)
-	if err != nil {
-		return respDetails, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
-	resp, parsedBody, err := h.executeReq(req) //nolint: bodyclose // closed in the method itself
+	resp, parsedBody, err := h.sendReq(ctx, request{ //nolint: bodyclose // closed in the method itself
+		method:      http.MethodPost,
+		path:        reqPath,
+		body:        payload.Bytes(),
+		contentType: contentType,
+		queryParams: queryParams,
+	})
 	if err != nil {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
 		return respDetails, err
@@ -219,9 +506,14 @@ func (h *HTTPHandler) postRequest(
 	} else {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
 		// MMS 4xx/5xx responses use the same response as 2xx responses
-		if _, ok := respResource.(*models.SendMMSResponse); ok {
+		exception := respDetails.ErrorResponse.RequestError.ServiceException
+		if mmsResp, ok := respResource.(*models.SendMMSResponse); ok {
 			_ = json.Unmarshal(parsedBody, &respResource)
+			if exception.Text == "" {
+				exception.Text = mmsResp.ErrorMessage
+			}
 		}
+		err = h.apiError(resp, parsedBody, exception)
 	}
 	return respDetails, err
 }
@@ -231,12 +523,8 @@ func (h *HTTPHandler) postNoBodyRequest(
 	respResource interface{},
 	reqPath string,
 ) (respDetails models.ResponseDetails, err error) {
-	req, err := h.createReq(ctx, http.MethodPost, reqPath, nil, nil)
-	if err != nil {
-		return respDetails, err
-	}
-
-	resp, parsedBody, err := h.executeReq(req) //nolint: bodyclose // closed in the method itself
+	resp, parsedBody, err := h.sendReq( //nolint: bodyclose // closed in the method itself
+		ctx, request{method: http.MethodPost, path: reqPath})
 	if err != nil {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
 		return respDetails, err
@@ -251,10 +539,33 @@ func (h *HTTPHandler) postNoBodyRequest(
 	} else {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
 		// MMS 4xx/5xx responses use the same response as 2xx responses
-		if _, ok := respResource.(*models.SendMMSResponse); ok {
+		exception := respDetails.ErrorResponse.RequestError.ServiceException
+		if mmsResp, ok := respResource.(*models.SendMMSResponse); ok {
 			_ = json.Unmarshal(parsedBody, &respResource)
+			if exception.Text == "" {
+				exception.Text = mmsResp.ErrorMessage
+			}
 		}
+		err = h.apiError(resp, parsedBody, exception)
+	}
+	return respDetails, err
+}
+
+// noContentRequest sends a request whose successful responses have no content to parse.
+func (h *HTTPHandler) noContentRequest(ctx context.Context, r request) (respDetails models.ResponseDetails, err error) {
+	resp, parsedBody, err := h.sendReq(ctx, r) //nolint: bodyclose // closed in the method itself
+	if err != nil {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		return respDetails, err
+	}
+	respDetails.HTTPResponse = *resp
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted &&
+		resp.StatusCode != http.StatusNoContent {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		err = h.apiError(resp, parsedBody, respDetails.ErrorResponse.RequestError.ServiceException)
 	}
+
 	return respDetails, err
 }
 
@@ -266,30 +577,62 @@ func (h *HTTPHandler) putRequest(
 	contentType string,
 	queryParams []QueryParameter,
 ) (respDetails models.ResponseDetails, err error) {
-	req, err := h.createReq(ctx, http.MethodPut, reqPath, payload, queryParams)
+	resp, parsedBody, err := h.sendReq(ctx, request{ //nolint: bodyclose // closed in the method itself
+		method:      http.MethodPut,
+		path:        reqPath,
+		body:        payload.Bytes(),
+		contentType: contentType,
+		queryParams: queryParams,
+	})
 	if err != nil {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
 		return respDetails, err
 	}
-	req.Header.Set("Content-Type", contentType)
+	respDetails.HTTPResponse = *resp
 
-	resp, parsedBody, err := h.executeReq(req) //nolint: bodyclose // closed in the method itself
+	if resp.StatusCode == http.StatusOK {
+		err = json.Unmarshal(parsedBody, &respResource)
+	} else {
+		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		err = h.apiError(resp, parsedBody, respDetails.ErrorResponse.RequestError.ServiceException)
+	}
+	return respDetails, err
+}
+
+// patchRequest sends a JSON payload with a PATCH request. Unlike PUT requests, PATCH requests may be accepted for
+// asynchronous processing or answered without content.
+func (h *HTTPHandler) patchRequest(
+	ctx context.Context,
+	payload *bytes.Buffer,
+	respResource interface{},
+	reqPath string,
+) (respDetails models.ResponseDetails, err error) {
+	resp, parsedBody, err := h.sendReq(ctx, request{ //nolint: bodyclose // closed in the method itself
+		method:      http.MethodPatch,
+		path:        reqPath,
+		body:        payload.Bytes(),
+		contentType: "application/json",
+	})
 	if err != nil {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
 		return respDetails, err
 	}
 	respDetails.HTTPResponse = *resp
 
-	if resp.StatusCode == http.StatusOK {
-		err = json.Unmarshal(parsedBody, &respResource)
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted ||
+		resp.StatusCode == http.StatusNoContent {
+		if len(parsedBody) > 0 {
+			err = json.Unmarshal(parsedBody, &respResource)
+		}
 	} else {
 		_ = json.Unmarshal(parsedBody, &respDetails.ErrorResponse)
+		err = h.apiError(resp, parsedBody, respDetails.ErrorResponse.RequestError.ServiceException)
 	}
 	return respDetails, err
 }
 
 func (h *HTTPHandler) generateCommonHeaders() http.Header {
 	header := http.Header{}
-	header.Add("Authorization", fmt.Sprintf("App %s", h.APIKey))
 	header.Add("Accept", "application/json")
 	header.Add("User-Agent", "@infobip/go-sdk/v3"+" go/"+runtime.Version())
 	return header
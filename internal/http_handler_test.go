@@ -111,7 +111,7 @@ func TestReqValidInput(t *testing.T) {
 			req, err := tc.handler.createReq(context.Background(), tc.method, tc.path, payloadBuf, nil)
 			require.NoError(t, err)
 
-			resp, body, err := tc.handler.executeReq(req)
+			resp, body, err := tc.handler.executeReq(req, nil)
 			require.NoError(t, err)
 			assert.NotNil(t, resp)
 			assert.Equal(t, []byte(tc.servResp), body)
@@ -134,7 +134,7 @@ func TestReqContext(t *testing.T) {
 	req, err := handler.createReq(ctx, http.MethodGet, "some/path", nil, nil)
 	require.NoError(t, err)
 
-	resp, _, err := handler.executeReq(req)
+	resp, _, err := handler.executeReq(req, nil)
 	require.NotNil(t, err)
 	assert.Nil(t, resp)
 }
@@ -159,7 +159,7 @@ func TestReqInvalidResBody(t *testing.T) {
 	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}
 	req, err := handler.createReq(context.Background(), http.MethodGet, "some/path", nil, nil)
 	require.NoError(t, err)
-	resp, _, err := handler.executeReq(req)
+	resp, _, err := handler.executeReq(req, nil)
 
 	require.NotNil(t, err)
 	assert.Contains(t, err.Error(), "unexpected EOF")
@@ -176,7 +176,7 @@ func TestReqInvalidHost(t *testing.T) {
 	req, err := handler.createReq(context.Background(), http.MethodGet, "some/path", nil, nil)
 	require.NoError(t, err)
 
-	resp, _, err := handler.executeReq(req)
+	resp, _, err := handler.executeReq(req, nil)
 	require.NotNil(t, err)
 	assert.Nil(t, resp)
 }
@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Instrumentation is notified of every operation executed by the SDK, e.g. to create tracing spans and record
+// metrics. An operation covers all the attempts made for a single method call.
+type Instrumentation interface {
+	// StartOperation is called before the first attempt of an operation. The returned context is used for
+	// all its attempts, so that it reaches the middlewares and the HTTP transport.
+	StartOperation(ctx context.Context, start OperationStart) (context.Context, OperationSpan)
+}
+
+// OperationSpan is ended once the operation is done.
+type OperationSpan interface {
+	End(end OperationEnd)
+}
+
+// OperationStart describes an operation which is about to be executed.
+type OperationStart struct {
+	Operation Operation
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the request path, with its parameters filled in.
+	Path string
+}
+
+// OperationEnd describes the outcome of an operation.
+type OperationEnd struct {
+	// StatusCode is the status code of the last response, or 0 if no response was received.
+	StatusCode int
+	// BulkID and MessageIDs are taken from the response body, when present.
+	BulkID     string
+	MessageIDs []string
+	// Attempts is the number of attempts made, including retries.
+	Attempts int
+	// Latency is the time spent on the operation, including retries and rate limiting.
+	Latency time.Duration
+	// Err is the transport error of the last attempt, if any. Non-2xx responses are reported by StatusCode only.
+	Err error
+}
+
+// startSpan starts the handler's instrumentation span. Requests not issued by a channel method, which have no
+// operation in their context, are named after their path.
+func (h *HTTPHandler) startSpan(ctx context.Context, method string, reqPath string) (context.Context, OperationSpan) {
+	operation, ok := OperationFromContext(ctx)
+	if !ok {
+		operation = Operation{Name: reqPath, PathTemplate: reqPath}
+	}
+
+	return h.Instrumentation.StartOperation(ctx, OperationStart{Operation: operation, Method: method, Path: reqPath})
+}
+
+// responseIDs holds the identifiers which responses of the various channels carry.
+type responseIDs struct {
+	BulkID    string `json:"bulkId"`
+	MessageID string `json:"messageId"`
+	Messages  []struct {
+		MessageID string `json:"messageId"`
+	} `json:"messages"`
+}
+
+func newOperationEnd(
+	resp *http.Response,
+	respBody []byte,
+	attempts int,
+	latency time.Duration,
+	err error,
+) OperationEnd {
+	end := OperationEnd{Attempts: attempts, Latency: latency, Err: err}
+	if resp == nil {
+		return end
+	}
+	end.StatusCode = resp.StatusCode
+
+	var ids responseIDs
+	if json.Unmarshal(respBody, &ids) != nil {
+		return end
+	}
+	end.BulkID = ids.BulkID
+	if ids.MessageID != "" {
+		end.MessageIDs = append(end.MessageIDs, ids.MessageID)
+	}
+	for _, msg := range ids.Messages {
+		if msg.MessageID != "" {
+			end.MessageIDs = append(end.MessageIDs, msg.MessageID)
+		}
+	}
+
+	return end
+}
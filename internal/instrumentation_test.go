@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type spanKey struct{}
+
+type recordingInstrumentation struct {
+	starts []OperationStart
+	ends   []OperationEnd
+}
+
+func (i *recordingInstrumentation) StartOperation(
+	ctx context.Context,
+	start OperationStart,
+) (context.Context, OperationSpan) {
+	i.starts = append(i.starts, start)
+	return context.WithValue(ctx, spanKey{}, len(i.starts)), i
+}
+
+func (i *recordingInstrumentation) End(end OperationEnd) {
+	i.ends = append(i.ends, end)
+}
+
+func TestInstrumentationSpansWholeOperation(t *testing.T) {
+	attempts := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write([]byte(`{"messageId": "some-message-id"}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	instrumentation := recordingInstrumentation{}
+	spanChecker := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, 1, req.Context().Value(spanKey{}))
+			return next.Do(req)
+		})
+	}
+	handler := HTTPHandler{
+		HTTPClient:      http.Client{},
+		BaseURL:         serv.URL,
+		RetryPolicy:     testRetryPolicy(),
+		Middlewares:     []Middleware{spanChecker},
+		Instrumentation: &instrumentation,
+	}
+	ctx := WithOperation(context.Background(), "Some.Operation", "some/{param}")
+	_, err := handler.PostNoBodyReq(ctx, &exampleResp{}, "some/path")
+	require.NoError(t, err)
+
+	assert.Equal(t, []OperationStart{{
+		Operation: Operation{Name: "Some.Operation", PathTemplate: "some/{param}"},
+		Method:    http.MethodPost,
+		Path:      "some/path",
+	}}, instrumentation.starts)
+	require.Len(t, instrumentation.ends, 1)
+	end := instrumentation.ends[0]
+	assert.Equal(t, http.StatusOK, end.StatusCode)
+	assert.Equal(t, 2, end.Attempts)
+	assert.Equal(t, []string{"some-message-id"}, end.MessageIDs)
+	assert.Empty(t, end.BulkID)
+	assert.Positive(t, end.Latency)
+	assert.NoError(t, end.Err)
+}
+
+func TestInstrumentationWithoutOperation(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer serv.Close()
+
+	instrumentation := recordingInstrumentation{}
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, Instrumentation: &instrumentation}
+	_, err := handler.DeleteRequest(context.Background(), "some/path", nil)
+	require.NoError(t, err)
+
+	require.Len(t, instrumentation.starts, 1)
+	assert.Equal(t, Operation{Name: "some/path", PathTemplate: "some/path"}, instrumentation.starts[0].Operation)
+	require.Len(t, instrumentation.ends, 1)
+	assert.Equal(t, http.StatusNoContent, instrumentation.ends[0].StatusCode)
+	assert.Empty(t, instrumentation.ends[0].MessageIDs)
+}
@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// Logger receives debug logs of every request sent and response received, with secrets redacted. Key-value
+// pairs follow the message, e.g. "method", "POST". It is satisfied by *slog.Logger, among others.
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+}
+
+// redactedQueryParams are the query parameters whose values are never logged.
+// nolint: gochecknoglobals // Read-only lookup table.
+var redactedQueryParams = map[string]bool{
+	"username": true,
+	"password": true,
+}
+
+// redactedJSONFields are the JSON fields whose values are never logged, wherever they appear in a body.
+// nolint: gochecknoglobals // Read-only lookup table.
+var redactedJSONFields = map[string]bool{
+	"apnsCertificateFileContent": true,
+	"apnsCertificatePassword":    true,
+	"fcmServerKey":               true,
+	"pin":                        true,
+}
+
+// logAttempt logs a request along with its outcome.
+func (h *HTTPHandler) logAttempt(
+	req *http.Request,
+	reqBody []byte,
+	resp *http.Response,
+	respBody []byte,
+	duration time.Duration,
+	err error,
+) {
+	keyvals := []interface{}{
+		"method", req.Method,
+		"url", redactURL(req.URL),
+		"headers", redactHeaders(req.Header),
+		"body", redactBody(req.Header.Get("Content-Type"), reqBody),
+		"duration", duration,
+	}
+	if operation, ok := OperationFromContext(req.Context()); ok {
+		keyvals = append([]interface{}{"operation", operation.Name}, keyvals...)
+	}
+
+	if err != nil {
+		h.Logger.Debug("infobip request failed", append(keyvals, "error", err.Error())...)
+		return
+	}
+	h.Logger.Debug("infobip request", append(keyvals,
+		"status", resp.StatusCode,
+		"responseHeaders", redactHeaders(resp.Header),
+		"responseBody", redactBody(resp.Header.Get("Content-Type"), respBody),
+	)...)
+}
+
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	for name := range query {
+		if redactedQueryParams[strings.ToLower(name)] {
+			query.Set(name, redacted)
+		}
+	}
+	redactedURL := *u
+	redactedURL.RawQuery = query.Encode()
+
+	return redactedURL.String()
+}
+
+// redactHeaders returns a copy of the headers with the credentials of the Authorization header replaced, keeping
+// its scheme, e.g. "App [REDACTED]".
+func redactHeaders(headers http.Header) http.Header {
+	redactedHeaders := headers.Clone()
+	for _, name := range []string{"Authorization", "Proxy-Authorization"} {
+		values := redactedHeaders.Values(name)
+		for i, value := range values {
+			if fields := strings.Fields(value); len(fields) > 1 {
+				values[i] = fields[0] + " " + redacted
+			} else {
+				values[i] = redacted
+			}
+		}
+	}
+
+	return redactedHeaders
+}
+
+// redactBody returns JSON bodies with their secret fields replaced. Other bodies, e.g. multipart ones which
+// may carry files, are only described by their size.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var parsed interface{}
+	if !strings.HasPrefix(contentType, "application/json") || json.Unmarshal(body, &parsed) != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+	redactedBody, err := json.Marshal(redactJSON(parsed))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+
+	return string(redactedBody)
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if redactedJSONFields[key] {
+				v[key] = redacted
+			} else {
+				v[key] = redactJSON(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+	}
+
+	return value
+}
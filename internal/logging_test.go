@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logEntry struct {
+	msg     string
+	keyvals map[string]interface{}
+}
+
+type recordingLogger struct {
+	entries []logEntry
+}
+
+func (l *recordingLogger) Debug(msg string, keyvals ...interface{}) {
+	entry := logEntry{msg: msg, keyvals: map[string]interface{}{}}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		entry.keyvals[fmt.Sprint(keyvals[i])] = keyvals[i+1]
+	}
+	l.entries = append(l.entries, entry)
+}
+
+func TestLoggerRedactsSecrets(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write([]byte(`{"pinId": "some-pin-id", "verified": true, "pin": "1234"}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	logger := recordingLogger{}
+	handler := HTTPHandler{APIKey: "secret", HTTPClient: http.Client{}, BaseURL: serv.URL, Logger: &logger}
+	ctx := WithOperation(context.Background(), "SMS.VerifyPhoneNumber", "2fa/2/pin/{pinId}/verify")
+	_, err := handler.PostJSONReqParams(
+		ctx,
+		&models.VerifyPhoneNumberRequest{PIN: "1234"},
+		&models.VerifyPhoneNumberResponse{},
+		"some/path",
+		[]QueryParameter{{Name: "username", Value: "user"}, {Name: "password", Value: "pass"}, {Name: "to", Value: "1"}},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, logger.entries, 1)
+	entry := logger.entries[0]
+	assert.Equal(t, "infobip request", entry.msg)
+	assert.Equal(t, "SMS.VerifyPhoneNumber", entry.keyvals["operation"])
+	assert.Equal(t, http.MethodPost, entry.keyvals["method"])
+	assert.Equal(t, http.StatusOK, entry.keyvals["status"])
+
+	loggedURL, err := url.Parse(entry.keyvals["url"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, redacted, loggedURL.Query().Get("username"))
+	assert.Equal(t, redacted, loggedURL.Query().Get("password"))
+	assert.Equal(t, "1", loggedURL.Query().Get("to"))
+
+	assert.Equal(t, "App [REDACTED]", entry.keyvals["headers"].(http.Header).Get("Authorization"))
+	assert.Equal(t, `{"pin":"[REDACTED]"}`, entry.keyvals["body"])
+	assert.JSONEq(t, `{"pinId": "some-pin-id", "verified": true, "pin": "[REDACTED]"}`,
+		entry.keyvals["responseBody"].(string))
+	assert.NotContains(t, fmt.Sprint(entry.keyvals), "secret")
+	assert.NotContains(t, fmt.Sprint(entry.keyvals), "1234")
+}
+
+func TestLoggerLogsFailedAttempts(t *testing.T) {
+	logger := recordingLogger{}
+	handler := HTTPHandler{
+		HTTPClient: http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, assert.AnError
+		})},
+		BaseURL:     "https://example.com",
+		RetryPolicy: testRetryPolicy(),
+		Logger:      &logger,
+	}
+
+	_, err := handler.GetRequest(context.Background(), &exampleResp{}, "some/path", nil)
+	require.Error(t, err)
+	require.Len(t, logger.entries, 3)
+	for _, entry := range logger.entries {
+		assert.Equal(t, "infobip request failed", entry.msg)
+		assert.Contains(t, entry.keyvals["error"], assert.AnError.Error())
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		scenario    string
+		contentType string
+		body        string
+		expected    string
+	}{
+		{scenario: "empty body", contentType: "application/json", body: "", expected: ""},
+		{
+			scenario:    "nested secrets",
+			contentType: "application/json",
+			body: `{"name": "app", "ios": {"apnsCertificateFileContent": "cert", "apnsCertificatePassword": "pass"},
+				"android": {"fcmServerKey": "key"}, "list": [{"pin": "1"}]}`,
+			expected: `{"android":{"fcmServerKey":"[REDACTED]"},"ios":{"apnsCertificateFileContent":"[REDACTED]",` +
+				`"apnsCertificatePassword":"[REDACTED]"},"list":[{"pin":"[REDACTED]"}],"name":"app"}`,
+		},
+		{scenario: "multipart body", contentType: "multipart/form-data; boundary=b", body: "--b\r\n", expected: "<5 bytes>"},
+		{scenario: "invalid JSON", contentType: "application/json", body: "{", expected: "<1 bytes>"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			assert.Equal(t, tc.expected, redactBody(tc.contentType, []byte(tc.body)))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+const requestIDLength = 16
+
+// Doer executes HTTP requests. It is implemented by *http.Client.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts a function to the Doer interface.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer which executes a request. It sees the fully built request, including headers,
+// and can read the operation being executed from the request context using OperationFromContext.
+type Middleware func(next Doer) Doer
+
+// Operation identifies the SDK method which issued a request.
+type Operation struct {
+	// Name is the channel and method name, e.g. "SMS.Send" or "WhatsApp.SendTemplate".
+	Name string
+	// PathTemplate is the request path with its parameters as placeholders,
+	// e.g. "whatsapp/2/senders/{sender}/templates".
+	PathTemplate string
+}
+
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx carrying the operation which is about to be executed.
+func WithOperation(ctx context.Context, name string, pathTemplate string) context.Context {
+	return context.WithValue(ctx, operationKey{}, Operation{Name: name, PathTemplate: pathTemplate})
+}
+
+// OperationFromContext returns the operation carried by ctx, if any.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	op, ok := ctx.Value(operationKey{}).(Operation)
+	return op, ok
+}
+
+// doer returns the handler's HTTP client wrapped in its middlewares, the first one being the outermost.
+func (h *HTTPHandler) doer() Doer {
+	var doer Doer = &h.HTTPClient
+	for i := len(h.Middlewares) - 1; i >= 0; i-- {
+		doer = h.Middlewares[i](doer)
+	}
+
+	return doer
+}
+
+// HeaderMiddleware returns a middleware which sets the given headers on every request.
+func HeaderMiddleware(headers http.Header) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			for name, values := range headers {
+				req.Header.Del(name)
+				for _, value := range values {
+					req.Header.Add(name, value)
+				}
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID to be sent by RequestIDMiddleware.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDMiddleware returns a middleware which sets the X-Request-Id header on every request. The ID is taken
+// from the request context when set with WithRequestID, so that it is shared by all the attempts of a request,
+// and randomly generated for every attempt otherwise.
+func RequestIDMiddleware() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			requestID, ok := req.Context().Value(requestIDKey{}).(string)
+			if !ok || requestID == "" {
+				var err error
+				if requestID, err = newRequestID(); err != nil {
+					return nil, err
+				}
+			}
+			req.Header.Set(requestIDHeader, requestID)
+			return next.Do(req)
+		})
+	}
+}
+
+func newRequestID() (string, error) {
+	id := make([]byte, requestIDLength)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
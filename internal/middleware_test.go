@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, []string{"first", "second"}, r.Header.Values("X-Trace"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer serv.Close()
+
+	var calls []string
+	tracer := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				req.Header.Add("X-Trace", name)
+				return next.Do(req)
+			})
+		}
+	}
+	handler := HTTPHandler{
+		HTTPClient:  http.Client{},
+		BaseURL:     serv.URL,
+		Middlewares: []Middleware{tracer("first"), tracer("second")},
+	}
+	respDetails, err := handler.DeleteRequest(context.Background(), "some/path", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestMiddlewareSeesBuiltRequestAndOperation(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer serv.Close()
+
+	var requests []*http.Request
+	recorder := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			requests = append(requests, req)
+			return next.Do(req)
+		})
+	}
+	handler := HTTPHandler{
+		APIKey:      "secret",
+		HTTPClient:  http.Client{},
+		BaseURL:     serv.URL,
+		RetryPolicy: testRetryPolicy(),
+		Middlewares: []Middleware{recorder},
+	}
+	ctx := WithOperation(context.Background(), "Some.Operation", "some/{param}")
+	_, err := handler.DeleteRequest(ctx, "some/path", nil)
+	require.NoError(t, err)
+
+	require.Len(t, requests, 3)
+	for _, req := range requests {
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Equal(t, "/some/path", req.URL.Path)
+		assert.Equal(t, "App secret", req.Header.Get("Authorization"))
+		operation, ok := OperationFromContext(req.Context())
+		require.True(t, ok)
+		assert.Equal(t, Operation{Name: "Some.Operation", PathTemplate: "some/{param}"}, operation)
+	}
+}
+
+func TestHeaderMiddleware(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "custom-agent", r.Header.Get("User-Agent"))
+		assert.Equal(t, []string{"a", "b"}, r.Header.Values("X-Custom"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer serv.Close()
+
+	headers := http.Header{"User-Agent": []string{"custom-agent"}, "X-Custom": []string{"a", "b"}}
+	handler := HTTPHandler{
+		HTTPClient:  http.Client{},
+		BaseURL:     serv.URL,
+		Middlewares: []Middleware{HeaderMiddleware(headers)},
+	}
+	_, err := handler.DeleteRequest(context.Background(), "some/path", nil)
+	require.NoError(t, err)
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var requestIDs []string
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-Id"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{
+		HTTPClient:  http.Client{},
+		BaseURL:     serv.URL,
+		Middlewares: []Middleware{RequestIDMiddleware()},
+	}
+	_, err := handler.DeleteRequest(WithRequestID(context.Background(), "some-id"), "some/path", nil)
+	require.NoError(t, err)
+	_, err = handler.DeleteRequest(context.Background(), "some/path", nil)
+	require.NoError(t, err)
+	_, err = handler.DeleteRequest(context.Background(), "some/path", nil)
+	require.NoError(t, err)
+
+	require.Len(t, requestIDs, 3)
+	assert.Equal(t, "some-id", requestIDs[0])
+	assert.Len(t, requestIDs[1], 32)
+	assert.NotEqual(t, requestIDs[1], requestIDs[2])
+}
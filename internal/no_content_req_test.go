@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostNoContentReqOK(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent} {
+		serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, int64(0), r.ContentLength)
+			w.WriteHeader(status)
+		}))
+
+		handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}
+		respDetails, err := handler.PostNoContentReq(context.Background(), "some/path")
+		serv.Close()
+
+		require.NoError(t, err)
+		assert.Equal(t, status, respDetails.HTTPResponse.StatusCode)
+		assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+	}
+}
+
+func TestPostNoContentReq4xx(t *testing.T) {
+	rawJSONResp := []byte(`{
+		"requestError": {
+			"serviceException": {
+				"messageId": "BAD_REQUEST",
+				"text": "Bad request"
+			}
+		}
+	}`)
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, servErr := w.Write(rawJSONResp)
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, ReturnAPIErrors: true}
+	respDetails, err := handler.PostNoContentReq(context.Background(), "some/path")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, http.StatusBadRequest, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "BAD_REQUEST", respDetails.ErrorResponse.RequestError.ServiceException.MessageID)
+}
+
+func TestPutJSONNoContentReqOK(t *testing.T) {
+	req := models.ConfirmWAIdentityRequest{Hash: "some-hash"}
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.Nil(t, servErr)
+
+		var receivedReq models.ConfirmWAIdentityRequest
+		servErr = json.Unmarshal(parsedBody, &receivedReq)
+		assert.Nil(t, servErr)
+		assert.Equal(t, req, receivedReq)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}
+	respDetails, err := handler.PutJSONNoContentReq(context.Background(), &req, "some/path")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestPutJSONNoContentInvalidPayload(t *testing.T) {
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}
+	respDetails, err := handler.PutJSONNoContentReq(
+		context.Background(), &models.ConfirmWAIdentityRequest{}, "some/path")
+
+	require.NotNil(t, err)
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"context"
+	"time"
+)
+
+// LogTimeLayout is the layout of the sentAt times of logs, and of the sentSince and sentUntil parameters.
+const LogTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// PageFetcher fetches the next page of an iteration. It is given the number of results which remain to be
+// returned, or 0 when there is no maximum, and returns the number of results of the page, and whether more pages
+// may follow.
+type PageFetcher func(ctx context.Context, remaining int) (size int, more bool, err error)
+
+// Pager drives the iterators of the channels, fetching pages as their results are consumed, and stopping once
+// the maximum number of results were returned, when there are no more pages, or when ctx is done.
+type Pager struct {
+	ctx   context.Context
+	fetch PageFetcher
+	max   int
+	index int
+	size  int
+	count int
+	more  bool
+	err   error
+}
+
+// NewPager creates a Pager returning at most maxResults results, or all of them when maxResults is not positive.
+func NewPager(ctx context.Context, maxResults int, fetch PageFetcher) *Pager {
+	return &Pager{ctx: ctx, fetch: fetch, max: maxResults, index: -1, more: true}
+}
+
+// Next advances to the next result, fetching the next page when the current one is consumed. It returns false
+// once the iteration is over.
+func (p *Pager) Next() bool {
+	if p.err != nil || (p.max > 0 && p.count >= p.max) {
+		return false
+	}
+
+	p.index++
+	for p.index >= p.size {
+		if !p.more {
+			return false
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+		remaining := 0
+		if p.max > 0 {
+			remaining = p.max - p.count
+		}
+		size, more, err := p.fetch(p.ctx, remaining)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.index, p.size, p.more = 0, size, more && size > 0
+	}
+	p.count++
+
+	return true
+}
+
+// Index returns the index of the current result in the current page.
+func (p *Pager) Index() int {
+	return p.index
+}
+
+// Err returns the error which ended the iteration, if any.
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// LogWindow narrows the sentSince/sentUntil window of log requests as pages of logs are fetched. Logs may be
+// returned from the oldest or from the newest, so the window is narrowed from the side the pages move towards.
+// Both bounds are inclusive, so that logs sent within the same millisecond are not missed, and the logs returned
+// again at the boundary are skipped.
+type LogWindow struct {
+	SentSince string
+	SentUntil string
+	boundary  time.Time
+	seen      map[string]bool
+}
+
+// Advance takes the sentAt times and message IDs of a page of logs, in order, and returns which logs were not
+// returned before, and whether more logs may follow. Pages of logs which were all returned before end the
+// iteration, which happens when more logs than the page size were sent within the same millisecond.
+func (w *LogWindow) Advance(sentAt []string, messageIDs []string) (fresh []bool, more bool, err error) {
+	if len(sentAt) == 0 {
+		return nil, false, nil
+	}
+	times := make([]time.Time, len(sentAt))
+	for i, value := range sentAt {
+		if times[i], err = time.Parse(LogTimeLayout, value); err != nil {
+			return nil, false, err
+		}
+	}
+
+	fresh = make([]bool, len(sentAt))
+	for i, id := range messageIDs {
+		fresh[i] = !(times[i].Equal(w.boundary) && w.seen[id])
+		more = more || fresh[i]
+	}
+
+	last := times[len(times)-1]
+	if !last.Equal(w.boundary) {
+		w.boundary = last
+		w.seen = map[string]bool{}
+	}
+	for i, id := range messageIDs {
+		if times[i].Equal(last) {
+			w.seen[id] = true
+		}
+	}
+	if last.Before(times[0]) {
+		w.SentUntil = sentAt[len(sentAt)-1]
+	} else {
+		w.SentSince = sentAt[len(sentAt)-1]
+	}
+
+	return fresh, more, nil
+}
+
+// FullPage tells whether a page of size results may be followed by more, which is when it holds limit results, or
+// any result when no limit was set.
+func FullPage(size int, limit int) bool {
+	return size > 0 && (limit <= 0 || size >= limit)
+}
+
+// PageLimit returns the limit of the next page, so that results which are returned only once, such as delivery
+// reports, are not fetched beyond the maximum of the iteration.
+func PageLimit(limit int, remaining int) int {
+	if remaining > 0 && (limit <= 0 || limit > remaining) {
+		return remaining
+	}
+
+	return limit
+}
@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPager(t *testing.T) {
+	pages := [][]int{{1, 2}, {3}, {}, {4}}
+	var page []int
+	var remainings []int
+	p := NewPager(context.Background(), 0, func(_ context.Context, remaining int) (int, bool, error) {
+		remainings = append(remainings, remaining)
+		page, pages = pages[0], pages[1:]
+		return len(page), true, nil
+	})
+
+	var values []int
+	for p.Next() {
+		values = append(values, page[p.Index()])
+	}
+
+	require.NoError(t, p.Err())
+	// Empty pages end the iteration.
+	assert.Equal(t, []int{1, 2, 3}, values)
+	assert.Equal(t, []int{0, 0, 0}, remainings)
+	assert.False(t, p.Next())
+}
+
+func TestPagerMaxResults(t *testing.T) {
+	var remainings []int
+	p := NewPager(context.Background(), 3, func(_ context.Context, remaining int) (int, bool, error) {
+		remainings = append(remainings, remaining)
+		return 2, true, nil
+	})
+
+	count := 0
+	for p.Next() {
+		count++
+	}
+
+	require.NoError(t, p.Err())
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []int{3, 1}, remainings)
+}
+
+func TestPagerErrors(t *testing.T) {
+	fetchErr := errors.New("fetch failed")
+	p := NewPager(context.Background(), 0, func(context.Context, int) (int, bool, error) {
+		return 0, false, fetchErr
+	})
+	assert.False(t, p.Next())
+	assert.Equal(t, fetchErr, p.Err())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fetches := 0
+	p = NewPager(ctx, 0, func(context.Context, int) (int, bool, error) {
+		fetches++
+		return 1, true, nil
+	})
+	assert.True(t, p.Next())
+	cancel()
+	assert.False(t, p.Next())
+	assert.Equal(t, context.Canceled, p.Err())
+	assert.Equal(t, 1, fetches)
+}
+
+func TestLogWindowAscending(t *testing.T) {
+	w := LogWindow{SentSince: "2022-01-01T00:00:00.000+0000"}
+
+	fresh, more, err := w.Advance(
+		[]string{"2022-01-01T10:00:00.000+0000", "2022-01-01T11:00:00.000+0000", "2022-01-01T11:00:00.000+0000"},
+		[]string{"a", "b", "c"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true, true}, fresh)
+	assert.True(t, more)
+	assert.Equal(t, "2022-01-01T11:00:00.000+0000", w.SentSince)
+	assert.Empty(t, w.SentUntil)
+
+	fresh, more, err = w.Advance(
+		[]string{"2022-01-01T11:00:00.000+0000", "2022-01-01T11:00:00.000+0000", "2022-01-01T11:00:00.000+0000"},
+		[]string{"b", "c", "d"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false, false, true}, fresh)
+	assert.True(t, more)
+
+	fresh, more, err = w.Advance(
+		[]string{"2022-01-01T11:00:00.000+0000", "2022-01-01T11:00:00.000+0000"},
+		[]string{"c", "d"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false, false}, fresh)
+	assert.False(t, more)
+}
+
+func TestLogWindowDescending(t *testing.T) {
+	w := LogWindow{}
+
+	fresh, more, err := w.Advance(
+		[]string{"2022-01-01T12:00:00.000+0100", "2022-01-01T10:00:00.000+0000"},
+		[]string{"a", "b"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true}, fresh)
+	assert.True(t, more)
+	assert.Equal(t, "2022-01-01T10:00:00.000+0000", w.SentUntil)
+	assert.Empty(t, w.SentSince)
+
+	_, _, err = w.Advance([]string{"yesterday"}, []string{"c"})
+	assert.Error(t, err)
+
+	_, more, err = w.Advance(nil, nil)
+	require.NoError(t, err)
+	assert.False(t, more)
+}
+
+func TestPageLimit(t *testing.T) {
+	assert.Equal(t, 50, PageLimit(50, 0))
+	assert.Equal(t, 10, PageLimit(50, 10))
+	assert.Equal(t, 10, PageLimit(0, 10))
+	assert.Equal(t, 0, PageLimit(0, 0))
+	assert.True(t, FullPage(3, 0))
+	assert.True(t, FullPage(3, 3))
+	assert.False(t, FullPage(2, 3))
+	assert.False(t, FullPage(0, 0))
+}
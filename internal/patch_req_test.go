@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchReqOK(t *testing.T) {
+	req := models.TemplateEdit{
+		Category:  "UTILITY",
+		Structure: models.TemplateStructure{Body: &models.TemplateStructureBody{Text: "body {{1}}"}, Type: "TEXT"},
+	}
+	rawJSONResp := []byte(`{"ID": "111", "name": "some_template", "status": "PENDING", "category": "UTILITY"}`)
+	var expectedResp models.CreateWATemplateResponse
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.Nil(t, servErr)
+
+		var receivedReq models.TemplateEdit
+		servErr = json.Unmarshal(parsedBody, &receivedReq)
+		assert.Nil(t, servErr)
+		assert.Equal(t, req, receivedReq)
+
+		w.WriteHeader(http.StatusAccepted)
+		_, servErr = w.Write(rawJSONResp)
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}
+	respResource := models.CreateWATemplateResponse{}
+	respDetails, err := handler.PatchJSONReq(context.Background(), &req, &respResource, "some/path")
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, respResource)
+	assert.Equal(t, http.StatusAccepted, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestPatchReqNoContent(t *testing.T) {
+	req := models.TemplateEdit{
+		Structure: models.TemplateStructure{Body: &models.TemplateStructureBody{Text: "body"}, Type: "TEXT"},
+	}
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}
+	respResource := models.CreateWATemplateResponse{}
+	respDetails, err := handler.PatchJSONReq(context.Background(), &req, &respResource, "some/path")
+
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateWATemplateResponse{}, respResource)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestPatchReq4xx(t *testing.T) {
+	rawJSONResp := []byte(`{
+		"requestError": {
+			"serviceException": {
+				"messageId": "BAD_REQUEST",
+				"text": "Bad request"
+			}
+		}
+	}`)
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, servErr := w.Write(rawJSONResp)
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	req := models.TemplateEdit{
+		Structure: models.TemplateStructure{Body: &models.TemplateStructureBody{Text: "body"}, Type: "TEXT"},
+	}
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}
+	respResource := models.CreateWATemplateResponse{}
+	respDetails, err := handler.PatchJSONReq(context.Background(), &req, &respResource, "some/path")
+
+	require.NoError(t, err)
+	assert.Equal(t, models.CreateWATemplateResponse{}, respResource)
+	assert.Equal(t, http.StatusBadRequest, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "BAD_REQUEST", respDetails.ErrorResponse.RequestError.ServiceException.MessageID)
+}
+
+func TestPatchInvalidPayload(t *testing.T) {
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}
+	respDetails, err := handler.PatchJSONReq(
+		context.Background(), &models.TemplateEdit{}, &models.CreateWATemplateResponse{}, "some/path")
+
+	require.NotNil(t, err)
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
@@ -0,0 +1,164 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit limits the rate of requests whose path starts with PathPrefix, e.g. "sms/" or "whatsapp/".
+// An empty PathPrefix matches every request which is not matched by a more specific prefix.
+type RateLimit struct {
+	PathPrefix string
+	// RequestsPerSecond is the rate at which the bucket is refilled. Limits with no positive rate are ignored.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests which can be sent at once. Values below 1 are treated as 1.
+	Burst int
+}
+
+// RateLimiter is a set of token buckets, one per path prefix. It is safe for concurrent use, and a single
+// RateLimiter is meant to be shared by all the channels of a client.
+type RateLimiter struct {
+	buckets []*tokenBucket
+}
+
+// NewRateLimiter returns a RateLimiter enforcing the given limits. Requests are limited by the bucket with the
+// longest matching prefix only.
+func NewRateLimiter(limits ...RateLimit) *RateLimiter {
+	limiter := RateLimiter{}
+	for _, limit := range limits {
+		if limit.RequestsPerSecond <= 0 {
+			continue
+		}
+		limiter.buckets = append(limiter.buckets, newTokenBucket(limit))
+	}
+	sort.SliceStable(limiter.buckets, func(i, j int) bool {
+		return len(limiter.buckets[i].prefix) > len(limiter.buckets[j].prefix)
+	})
+
+	return &limiter
+}
+
+// Wait blocks until a request to reqPath is allowed. It returns an error if the context is canceled, or if
+// its deadline would pass before the request is allowed.
+func (l *RateLimiter) Wait(ctx context.Context, reqPath string) error {
+	bucket := l.bucketFor(reqPath)
+	if bucket == nil {
+		return nil
+	}
+
+	delay := bucket.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	if !sleepCtx(ctx, delay) {
+		bucket.cancel()
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return context.DeadlineExceeded
+	}
+
+	return nil
+}
+
+// Pause stops requests to reqPath from being allowed for the given duration. It is called when the server
+// responds with 429 and a Retry-After header, so that all the requests sharing the bucket back off.
+func (l *RateLimiter) Pause(reqPath string, duration time.Duration) {
+	if bucket := l.bucketFor(reqPath); bucket != nil {
+		bucket.pause(time.Now().Add(duration))
+	}
+}
+
+func (l *RateLimiter) bucketFor(reqPath string) *tokenBucket {
+	if l == nil {
+		return nil
+	}
+	for _, bucket := range l.buckets {
+		if strings.HasPrefix(reqPath, bucket.prefix) {
+			return bucket
+		}
+	}
+
+	return nil
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	prefix string
+	rate   float64
+	burst  float64
+	tokens float64
+	// last is the time up to which tokens have been refilled. It lies in the future while the bucket is paused.
+	last time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		prefix: limit.PathPrefix,
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket, going into debt if there is none available, and returns how long
+// the caller has to wait before the token is actually available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	available := b.last.Add(time.Duration(-b.tokens / b.rate * float64(time.Second)))
+	return available.Sub(now)
+}
+
+// cancel returns a token which was reserved but not used.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+// pause empties the bucket and stops refilling it until the given time.
+func (b *tokenBucket) pause(until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(time.Now())
+	if b.tokens > 0 {
+		b.tokens = 0
+	}
+	if until.After(b.last) {
+		b.last = until
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last)
+	if elapsed <= 0 {
+		return
+	}
+	b.last = now
+	b.tokens += elapsed.Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
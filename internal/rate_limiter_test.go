@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterBucketSelection(t *testing.T) {
+	limiter := NewRateLimiter(
+		RateLimit{PathPrefix: "", RequestsPerSecond: 1},
+		RateLimit{PathPrefix: "sms/", RequestsPerSecond: 1},
+		RateLimit{PathPrefix: "sms/2/", RequestsPerSecond: 1},
+		RateLimit{PathPrefix: "email/", RequestsPerSecond: 0},
+	)
+
+	assert.Equal(t, "sms/2/", limiter.bucketFor("sms/2/text/advanced").prefix)
+	assert.Equal(t, "sms/", limiter.bucketFor("sms/1/reports").prefix)
+	assert.Equal(t, "", limiter.bucketFor("whatsapp/1/message/text").prefix)
+	assert.Equal(t, "", limiter.bucketFor("email/2/send").prefix)
+
+	limiter = NewRateLimiter(RateLimit{PathPrefix: "sms/", RequestsPerSecond: 1})
+	assert.Nil(t, limiter.bucketFor("whatsapp/1/message/text"))
+	assert.NoError(t, limiter.Wait(context.Background(), "whatsapp/1/message/text"))
+
+	var nilLimiter *RateLimiter
+	assert.NoError(t, nilLimiter.Wait(context.Background(), "sms/1/reports"))
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := NewRateLimiter(RateLimit{PathPrefix: "sms/", RequestsPerSecond: 20, Burst: 2})
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, limiter.Wait(context.Background(), "sms/1/reports"))
+	}
+	elapsed := time.Since(start)
+
+	// Two requests fit in the burst, the other two wait for 50ms each.
+	assert.GreaterOrEqual(t, elapsed, 90*time.Millisecond)
+	assert.Less(t, elapsed, time.Second)
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	limiter := NewRateLimiter(RateLimit{PathPrefix: "sms/", RequestsPerSecond: 1})
+	require.NoError(t, limiter.Wait(context.Background(), "sms/1/reports"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	err := limiter.Wait(ctx, "sms/1/reports")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err = limiter.Wait(ctx, "sms/1/reports")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Canceled reservations are returned to the bucket.
+	assert.InDelta(t, 0, limiter.bucketFor("sms/").tokens, 0.5)
+}
+
+func TestRateLimiterPause(t *testing.T) {
+	limiter := NewRateLimiter(RateLimit{PathPrefix: "whatsapp/", RequestsPerSecond: 1000, Burst: 10})
+	limiter.Pause("whatsapp/1/message/text", 100*time.Millisecond)
+	limiter.Pause("sms/2/text/advanced", time.Hour)
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(context.Background(), "whatsapp/1/message/image"))
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimiterHandlerPausesOnRetryAfter(t *testing.T) {
+	attempts := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer serv.Close()
+
+	limiter := NewRateLimiter(RateLimit{PathPrefix: "some/", RequestsPerSecond: 1000, Burst: 10})
+	smsHandler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, RateLimiter: limiter}
+	whatsAppHandler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, RateLimiter: limiter}
+
+	respDetails, err := smsHandler.DeleteRequest(context.Background(), "some/path", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, respDetails.HTTPResponse.StatusCode)
+
+	start := time.Now()
+	respDetails, err = whatsAppHandler.DeleteRequest(context.Background(), "some/other/path", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestRateLimiterHandlerCanceled(t *testing.T) {
+	attempts := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+	}))
+	defer serv.Close()
+
+	limiter := NewRateLimiter(RateLimit{RequestsPerSecond: 0.1})
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, RateLimiter: limiter}
+	_, err := handler.DeleteRequest(context.Background(), "some/path", nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = handler.DeleteRequest(ctx, "some/path", nil)
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
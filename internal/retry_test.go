@@ -0,0 +1,246 @@
+package internal
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+}
+
+func TestRetryOnRetryableStatus(t *testing.T) {
+	tests := []struct {
+		scenario         string
+		statuses         []int
+		expectedAttempts int
+		expectedStatus   int
+	}{
+		{scenario: "success on first attempt", statuses: []int{200}, expectedAttempts: 1, expectedStatus: 200},
+		{scenario: "5xx then success", statuses: []int{503, 200}, expectedAttempts: 2, expectedStatus: 200},
+		{scenario: "429 then success", statuses: []int{429, 429, 200}, expectedAttempts: 3, expectedStatus: 200},
+		{scenario: "attempts exhausted", statuses: []int{500, 502, 504, 200}, expectedAttempts: 3, expectedStatus: 504},
+		{scenario: "4xx is not retried", statuses: []int{400, 200}, expectedAttempts: 1, expectedStatus: 400},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			attempts := 0
+			serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statuses[attempts])
+				attempts++
+				_, servErr := w.Write([]byte(`{"id": 1, "name": "John"}`))
+				assert.Nil(t, servErr)
+			}))
+			defer serv.Close()
+
+			handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, RetryPolicy: testRetryPolicy()}
+			respResource := exampleResp{}
+			respDetails, err := handler.GetRequest(context.Background(), &respResource, "some/path", nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAttempts, attempts)
+			assert.Equal(t, tc.expectedStatus, respDetails.HTTPResponse.StatusCode)
+		})
+	}
+}
+
+func TestRetryDisabledByDefault(t *testing.T) {
+	attempts := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}
+	respDetails, err := handler.DeleteRequest(context.Background(), "some/path", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestRetryIdempotentOnly(t *testing.T) {
+	attempts := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer serv.Close()
+
+	policy := testRetryPolicy()
+	policy.IdempotentOnly = true
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, RetryPolicy: policy}
+	msg := models.WATextMsg{
+		MsgCommon: models.GenerateTestMsgCommon(),
+		Content:   models.TextContent{Text: "hello world"},
+	}
+	_, err := handler.PostJSONReq(context.Background(), &msg, &models.SendWAMsgResponse{}, "some/path")
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+
+	attempts = 0
+	_, err = handler.DeleteRequest(context.Background(), "some/path", nil)
+	require.NoError(t, err)
+	assert.Equal(t, policy.MaxAttempts, attempts)
+}
+
+func TestRetryTransportError(t *testing.T) {
+	attempts := 0
+	handler := HTTPHandler{
+		HTTPClient: http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return nil, assert.AnError
+		})},
+		BaseURL:     "https://example.com",
+		RetryPolicy: testRetryPolicy(),
+	}
+
+	_, err := handler.GetRequest(context.Background(), &exampleResp{}, "some/path", nil)
+	require.Error(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	attempts := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, RetryPolicy: testRetryPolicy()}
+	start := time.Now()
+	respDetails, err := handler.PostNoBodyReq(context.Background(), &exampleResp{}, "some/path")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRetryRespectsContextDeadline(t *testing.T) {
+	attempts := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, RetryPolicy: testRetryPolicy()}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	respDetails, err := handler.GetRequest(ctx, &exampleResp{}, "some/path", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, http.StatusTooManyRequests, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestRetryReplaysMultipartBody(t *testing.T) {
+	image, err := os.Open("testdata/image.png")
+	require.NoError(t, err)
+	msg := models.MMSMsg{
+		Head:  models.MMSHead{From: "38598765432", To: "38591234567"},
+		Text:  "some text",
+		Media: image,
+	}
+
+	var bodies [][]byte
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.Nil(t, servErr)
+		bodies = append(bodies, parsedBody)
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, servErr = w.Write([]byte(`{"bulkId": "some-bulk-id"}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	handler := HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, RetryPolicy: testRetryPolicy()}
+	respResource := models.SendMMSResponse{}
+	respDetails, err := handler.PostMultipartReq(context.Background(), &msg, &respResource, "some/path")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "some-bulk-id", respResource.BulkID)
+	require.Len(t, bodies, 2)
+	assert.Greater(t, len(bodies[0]), 100)
+	assert.Equal(t, bodies[0], bodies[1])
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    10,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3))
+	assert.Equal(t, time.Second, policy.backoff(6))
+
+	policy.Jitter = 0.5
+	for attempt := 1; attempt < 10; attempt++ {
+		delay := policy.backoff(attempt)
+		assert.LessOrEqual(t, delay, time.Second)
+		assert.GreaterOrEqual(t, delay, 50*time.Millisecond)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value         string
+		expectedDelay time.Duration
+		expectedOK    bool
+	}{
+		{value: "", expectedOK: false},
+		{value: "5", expectedDelay: 5 * time.Second, expectedOK: true},
+		{value: "-1", expectedOK: false},
+		{value: "Sat, 01 Jan 2022 12:00:30 GMT", expectedDelay: 30 * time.Second, expectedOK: true},
+		{value: "Sat, 01 Jan 2022 11:00:00 GMT", expectedDelay: 0, expectedOK: true},
+		{value: "soon", expectedOK: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			delay, ok := parseRetryAfter(tc.value, now)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedDelay, delay)
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,92 @@
+// Package auth provides the authenticators supported by the Infobip API, to be used with
+// infobip.WithAuthenticator: API keys, Basic credentials, IBSSO session tokens and OAuth2 access tokens.
+// https://www.infobip.com/docs/essentials/api-authentication
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// ErrNoToken is returned when an authentication endpoint responds successfully, but without a token.
+var ErrNoToken = errors.New("no token in response")
+
+// APIKey authenticates requests with an API key.
+type APIKey string
+
+// Authenticate implements infobip.Authenticator.
+func (k APIKey) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", fmt.Sprintf("App %s", k))
+	return nil
+}
+
+// Basic authenticates requests with the username and password of an Infobip account.
+type Basic struct {
+	Username string
+	Password string
+}
+
+// Authenticate implements infobip.Authenticator.
+func (b Basic) Authenticate(req *http.Request) error {
+	credentials := base64.StdEncoding.EncodeToString([]byte(b.Username + ":" + b.Password))
+	req.Header.Set("Authorization", fmt.Sprintf("Basic %s", credentials))
+	return nil
+}
+
+// resolveURL joins a base URL, defaulting to HTTPS like infobip.NewClient does, with a resource path.
+func resolveURL(baseURL string, resourcePath string) string {
+	if !strings.Contains(baseURL, "://") {
+		baseURL = "https://" + baseURL
+	}
+
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(baseURL, "/"), resourcePath)
+}
+
+func httpClientOrDefault(client *http.Client) *http.Client {
+	if client == nil {
+		return http.DefaultClient
+	}
+
+	return client
+}
+
+// doAuthReq executes a request to an authentication endpoint, decoding a successful response into respResource.
+// Unsuccessful responses are returned as an *infobip.APIError.
+func doAuthReq(client *http.Client, req *http.Request, respResource interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := httpClientOrDefault(client).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		var details models.ErrorDetails
+		_ = json.Unmarshal(body, &details)
+		exception := details.RequestError.ServiceException
+		return &internal.APIError{
+			StatusCode:       resp.StatusCode,
+			MessageID:        exception.MessageID,
+			Text:             exception.Text,
+			ValidationErrors: exception.ValidationErrors,
+			Body:             body,
+		}
+	}
+	if respResource == nil || len(body) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, respResource)
+}
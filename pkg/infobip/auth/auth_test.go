@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKey(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, APIKey("secret").Authenticate(req))
+	assert.Equal(t, "App secret", req.Header.Get("Authorization"))
+}
+
+func TestBasic(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, Basic{Username: "user", Password: "pass"}.Authenticate(req))
+	assert.Equal(t, "Basic dXNlcjpwYXNz", req.Header.Get("Authorization"))
+	username, password, ok := req.BasicAuth()
+	require.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass", password)
+}
+
+func TestResolveURL(t *testing.T) {
+	assert.Equal(t, "https://some.api.infobip.com/auth/1/session", resolveURL("some.api.infobip.com", ibssoSessionPath))
+	assert.Equal(t, "http://127.0.0.1:80/auth/1/session", resolveURL("http://127.0.0.1:80/", ibssoSessionPath))
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	ibssoSessionPath      = "auth/1/session"
+	defaultSessionTimeout = 50 * time.Minute
+)
+
+// IBSSO authenticates requests with an IBSSO session token. The session is created with the credentials of an
+// Infobip account on first use, and created again once it may have expired.
+type IBSSO struct {
+	// BaseURL is the Infobip base URL, as passed to infobip.NewClient.
+	BaseURL  string
+	Username string
+	Password string
+	// HTTPClient is used to create and destroy sessions. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// SessionTimeout is the period of inactivity after which the session is considered expired. Defaults to
+	// 50 minutes, below the 60 minutes after which sessions expire unless configured otherwise.
+	SessionTimeout time.Duration
+
+	mu       sync.Mutex
+	token    string
+	lastUsed time.Time
+}
+
+type ibssoSessionRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type ibssoSessionResponse struct {
+	Token string `json:"token"`
+}
+
+// Authenticate implements infobip.Authenticator, creating a session first if needed.
+func (s *IBSSO) Authenticate(req *http.Request) error {
+	token, err := s.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("IBSSO %s", token))
+	return nil
+}
+
+// Token returns the token of the current session, creating a new session if there is none or if the current one
+// may have expired.
+func (s *IBSSO) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.token == "" || now.Sub(s.lastUsed) >= s.sessionTimeout() {
+		token, err := s.createSession(ctx)
+		if err != nil {
+			return "", err
+		}
+		s.token = token
+	}
+	s.lastUsed = now
+
+	return s.token, nil
+}
+
+// Invalidate drops the current session, so that a new one is created for the next request. It is called by the
+// client when a request is rejected with a 401 status code.
+func (s *IBSSO) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = ""
+}
+
+// Close destroys the current session, if any.
+func (s *IBSSO) Close(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == "" {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, resolveURL(s.BaseURL, ibssoSessionPath), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("IBSSO %s", s.token))
+	if err = doAuthReq(s.HTTPClient, req, nil); err != nil {
+		return err
+	}
+	s.token = ""
+
+	return nil
+}
+
+func (s *IBSSO) createSession(ctx context.Context) (string, error) {
+	payload, err := json.Marshal(ibssoSessionRequest{Username: s.Username, Password: s.Password})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, resolveURL(s.BaseURL, ibssoSessionPath), bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var session ibssoSessionResponse
+	if err = doAuthReq(s.HTTPClient, req, &session); err != nil {
+		return "", fmt.Errorf("creating IBSSO session: %w", err)
+	}
+	if session.Token == "" {
+		return "", fmt.Errorf("creating IBSSO session: %w", ErrNoToken)
+	}
+
+	return session.Token, nil
+}
+
+func (s *IBSSO) sessionTimeout() time.Duration {
+	if s.SessionTimeout <= 0 {
+		return defaultSessionTimeout
+	}
+
+	return s.SessionTimeout
+}
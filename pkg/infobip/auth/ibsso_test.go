@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBSSOCreatesAndReusesSession(t *testing.T) {
+	sessions := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/1/session":
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			var body ibssoSessionRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, ibssoSessionRequest{Username: "user", Password: "pass"}, body)
+			sessions++
+			w.WriteHeader(http.StatusOK)
+			_, servErr := fmt.Fprintf(w, `{"token": "token-%d"}`, sessions)
+			assert.Nil(t, servErr)
+		default:
+			assert.Equal(t, fmt.Sprintf("IBSSO token-%d", sessions), r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusOK)
+			_, servErr := w.Write([]byte(`[]`))
+			assert.Nil(t, servErr)
+		}
+	}))
+	defer serv.Close()
+
+	ibsso := IBSSO{BaseURL: serv.URL, Username: "user", Password: "pass", SessionTimeout: 100 * time.Millisecond}
+	client, err := infobip.NewClient(serv.URL, "", infobip.WithAuthenticator(&ibsso))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, _, err = client.SMS.GetTFAApplications(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, sessions)
+
+	time.Sleep(150 * time.Millisecond)
+	_, _, err = client.SMS.GetTFAApplications(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, sessions)
+}
+
+func TestIBSSOInvalidatedOnUnauthorized(t *testing.T) {
+	sessions := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/1/session" {
+			sessions++
+			w.WriteHeader(http.StatusOK)
+			_, servErr := fmt.Fprintf(w, `{"token": "token-%d"}`, sessions)
+			assert.Nil(t, servErr)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer serv.Close()
+
+	ibsso := IBSSO{BaseURL: serv.URL, Username: "user", Password: "pass"}
+	client, err := infobip.NewClient(serv.URL, "", infobip.WithAuthenticator(&ibsso))
+	require.NoError(t, err)
+
+	_, err = client.WebRTC.DeleteApplication(context.Background(), "some-id")
+	require.NoError(t, err)
+	_, err = client.WebRTC.DeleteApplication(context.Background(), "some-id")
+	require.NoError(t, err)
+	assert.Equal(t, 2, sessions)
+}
+
+func TestIBSSOCreateSessionError(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, servErr := w.Write([]byte(`{
+			"requestError": {"serviceException": {"messageId": "UNAUTHORIZED", "text": "Invalid login details"}}
+		}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	ibsso := IBSSO{BaseURL: serv.URL, Username: "user", Password: "wrong"}
+	_, err := ibsso.Token(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, infobip.ErrUnauthorized))
+	var apiErr *infobip.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Invalid login details", apiErr.Text)
+}
+
+func TestIBSSOClose(t *testing.T) {
+	deleted := false
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			assert.Equal(t, "IBSSO some-token", r.Header.Get("Authorization"))
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write([]byte(`{"token": "some-token"}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	ibsso := IBSSO{BaseURL: serv.URL, Username: "user", Password: "pass"}
+	require.NoError(t, ibsso.Close(context.Background()))
+	assert.False(t, deleted)
+
+	_, err := ibsso.Token(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, ibsso.Close(context.Background()))
+	assert.True(t, deleted)
+}
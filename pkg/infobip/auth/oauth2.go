@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	oauth2TokenPath      = "auth/1/oauth2/token"
+	defaultRefreshBefore = time.Minute
+)
+
+// OAuth2ClientCredentials authenticates requests with OAuth2 access tokens obtained through the client credentials
+// grant. Tokens are requested on first use, and requested again shortly before they expire.
+type OAuth2ClientCredentials struct {
+	// BaseURL is the Infobip base URL, as passed to infobip.NewClient.
+	BaseURL      string
+	ClientID     string
+	ClientSecret string
+	// HTTPClient is used to request tokens. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// RefreshBefore is how long before its expiry a token is replaced. Defaults to one minute.
+	RefreshBefore time.Duration
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Authenticate implements infobip.Authenticator, requesting a token first if needed.
+func (o *OAuth2ClientCredentials) Authenticate(req *http.Request) error {
+	token, err := o.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
+// Token returns the current access token, requesting a new one if there is none or if it is about to expire.
+// Tokens sent without an expiry are kept until invalidated.
+func (o *OAuth2ClientCredentials) Token(ctx context.Context) (string, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.token != "" && (o.expiry.IsZero() || time.Now().Before(o.expiry.Add(-o.refreshBefore()))) {
+		return o.token, nil
+	}
+
+	resp, err := o.requestToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	o.token = resp.AccessToken
+	o.expiry = time.Time{}
+	if resp.ExpiresIn > 0 {
+		o.expiry = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+
+	return o.token, nil
+}
+
+// Invalidate drops the current token, so that a new one is requested for the next request. It is called by the
+// client when a request is rejected with a 401 status code.
+func (o *OAuth2ClientCredentials) Invalidate() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.token = ""
+}
+
+func (o *OAuth2ClientCredentials) requestToken(ctx context.Context) (oauth2TokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", o.ClientID)
+	form.Set("client_secret", o.ClientSecret)
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, resolveURL(o.BaseURL, oauth2TokenPath), strings.NewReader(form.Encode()))
+	if err != nil {
+		return oauth2TokenResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp oauth2TokenResponse
+	if err = doAuthReq(o.HTTPClient, req, &resp); err != nil {
+		return oauth2TokenResponse{}, fmt.Errorf("requesting OAuth2 token: %w", err)
+	}
+	if resp.AccessToken == "" {
+		return oauth2TokenResponse{}, fmt.Errorf("requesting OAuth2 token: %w", ErrNoToken)
+	}
+
+	return resp, nil
+}
+
+func (o *OAuth2ClientCredentials) refreshBefore() time.Duration {
+	if o.RefreshBefore <= 0 {
+		return defaultRefreshBefore
+	}
+
+	return o.RefreshBefore
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokenServer(t *testing.T, expiresIn int, tokens *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/auth/1/oauth2/token" {
+			assert.Equal(t, fmt.Sprintf("Bearer token-%d", *tokens), r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusOK)
+			_, servErr := w.Write([]byte(`[]`))
+			assert.Nil(t, servErr)
+			return
+		}
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "some-id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "some-secret", r.PostForm.Get("client_secret"))
+		*tokens++
+		w.WriteHeader(http.StatusOK)
+		_, servErr := fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": %d}`,
+			*tokens, expiresIn)
+		assert.Nil(t, servErr)
+	}))
+}
+
+func TestOAuth2ClientCredentialsReusesToken(t *testing.T) {
+	tokens := 0
+	serv := newTokenServer(t, 3600, &tokens)
+	defer serv.Close()
+
+	oauth2 := OAuth2ClientCredentials{BaseURL: serv.URL, ClientID: "some-id", ClientSecret: "some-secret"}
+	client, err := infobip.NewClient(serv.URL, "", infobip.WithAuthenticator(&oauth2))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, _, err = client.SMS.GetTFAApplications(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, tokens)
+
+	oauth2.Invalidate()
+	_, _, err = client.SMS.GetTFAApplications(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, tokens)
+}
+
+func TestOAuth2ClientCredentialsRefreshesBeforeExpiry(t *testing.T) {
+	tokens := 0
+	serv := newTokenServer(t, 30, &tokens)
+	defer serv.Close()
+
+	oauth2 := OAuth2ClientCredentials{BaseURL: serv.URL, ClientID: "some-id", ClientSecret: "some-secret"}
+	client, err := infobip.NewClient(serv.URL, "", infobip.WithAuthenticator(&oauth2))
+	require.NoError(t, err)
+
+	// Tokens expiring within the default one-minute margin are replaced on every request.
+	for i := 0; i < 2; i++ {
+		_, _, err = client.SMS.GetTFAApplications(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, tokens)
+
+	oauth2.RefreshBefore = 10 * time.Second
+	_, _, err = client.SMS.GetTFAApplications(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, tokens)
+}
+
+func TestOAuth2ClientCredentialsError(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write([]byte(`{"token_type": "Bearer"}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	oauth2 := OAuth2ClientCredentials{BaseURL: serv.URL, ClientID: "some-id", ClientSecret: "some-secret"}
+	client, err := infobip.NewClient(serv.URL, "", infobip.WithAuthenticator(&oauth2))
+	require.NoError(t, err)
+
+	_, _, err = client.SMS.GetTFAApplications(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNoToken))
+}
@@ -0,0 +1,86 @@
+// Package cassette provides an http.RoundTripper recording the interactions of a client with the Infobip API to a
+// file, called a cassette, and replaying them offline, e.g. to run live tests deterministically in CI:
+//
+//	recorder, err := cassette.New("testdata/send_sms.json", cassette.ModeAuto)
+//	client, err := infobip.NewClient(baseURL, apiKey, infobip.WithHTTPClient(recorder.HTTPClient()))
+//	// Use the client, then write the cassette when recording.
+//	err = recorder.Save()
+//
+// Credentials are never written to cassettes, and phone numbers are replaced with placeholders, which are replaced
+// back with the numbers of the requests when replaying.
+package cassette
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+const cassettePerm = 0o644
+
+// Cassette is a list of recorded interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response the API returned for it.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a scrubbed request. Its method, path, query and body are matched against the requests replayed.
+type Request struct {
+	Method  string              `json:"method"`
+	Path    string              `json:"path"`
+	Query   string              `json:"query,omitempty"`
+	Headers map[string][]string `json:"headers,omitempty"`
+	// Body is the normalized body: JSON is compacted with sorted keys, and multipart bodies are converted to a JSON
+	// list of their parts, without their boundaries.
+	Body string `json:"body,omitempty"`
+}
+
+// Response is a scrubbed response.
+type Response struct {
+	StatusCode int                 `json:"statusCode"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body,omitempty"`
+	// BodyBase64 holds the bodies which are not valid UTF-8, e.g. downloaded media.
+	BodyBase64 []byte `json:"bodyBase64,omitempty"`
+}
+
+// load reads a cassette file.
+func load(path string) (Cassette, error) {
+	var cassette Cassette
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return cassette, err
+	}
+	err = json.Unmarshal(content, &cassette)
+
+	return cassette, err
+}
+
+// save writes a cassette file, creating its directory if needed.
+func save(path string, cassette Cassette) error {
+	content, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(content, '\n'), cassettePerm)
+}
+
+// exists reports whether a cassette file exists.
+func exists(path string) (bool, error) {
+	_, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
@@ -0,0 +1,199 @@
+package cassette
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode tells whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions of an existing cassette, and fails the requests it has no interaction for.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the API, and records them along with their responses.
+	ModeRecord
+	// ModeAuto replays the cassette if it exists, and records it otherwise.
+	ModeAuto
+)
+
+// ErrNoInteraction is returned when replaying a request which matches none of the unused recorded interactions.
+var ErrNoInteraction = errors.New("cassette: no recorded interaction matches the request")
+
+// Recorder is an http.RoundTripper recording or replaying the interactions of a cassette. Each recorded interaction
+// is replayed once, in the order of the recording. It is safe for concurrent use, although requests sent
+// concurrently may not be replayed deterministically.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+	scrubber *scrubber
+}
+
+// WithTransport sets the transport requests are sent with when recording. It defaults to http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) func(*Recorder) {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// New creates a Recorder of the cassette at path. Cassettes are read when the Recorder is created, and written by
+// Save.
+func New(path string, mode Mode, options ...func(*Recorder)) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, transport: http.DefaultTransport, scrubber: newScrubber()}
+	for _, opt := range options {
+		opt(r)
+	}
+
+	if r.mode == ModeAuto {
+		found, err := exists(path)
+		if err != nil {
+			return nil, err
+		}
+		r.mode = ModeRecord
+		if found {
+			r.mode = ModeReplay
+		}
+	}
+
+	if r.mode == ModeReplay {
+		cassette, err := load(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = cassette
+		r.used = make([]bool, len(cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// Mode returns whether the Recorder records or replays. ModeAuto is resolved when the Recorder is created.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// HTTPClient returns an HTTP client using the Recorder, to create clients with infobip.WithHTTPClient.
+func (r *Recorder) HTTPClient() http.Client {
+	return http.Client{Transport: r}
+}
+
+// Save writes the recorded interactions to the cassette. It does nothing when replaying.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return save(r.path, r.cassette)
+}
+
+// RoundTrip records or replays a request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+
+	return r.replay(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	sent := req.Clone(req.Context())
+	sent.Body = io.NopCloser(bytes.NewReader(body))
+	resp, err := r.transport.RoundTrip(sent)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	interaction := Interaction{Request: r.scrubber.request(req, body)}
+	if utf8.Valid(respBody) {
+		interaction.Response = r.scrubber.response(resp, respBody)
+	} else {
+		interaction.Response = r.scrubber.response(resp, nil)
+		interaction.Response.BodyBase64 = respBody
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	scrubbed := r.scrubber.request(req, body)
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !matches(interaction.Request, scrubbed) {
+			continue
+		}
+		r.used[i] = true
+
+		return r.response(req, interaction.Response), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL)
+}
+
+func (r *Recorder) response(req *http.Request, recorded Response) *http.Response {
+	header := http.Header{}
+	for name, values := range recorded.Headers {
+		header[name] = append([]string{}, values...)
+	}
+	body := recorded.BodyBase64
+	if recorded.Body != "" {
+		body = []byte(r.scrubber.restoreBody(header.Get("Content-Type"), recorded.Body))
+	}
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// readBody reads the body of a request, and replaces it so that it can be read again.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
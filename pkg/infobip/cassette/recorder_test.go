@@ -0,0 +1,169 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/infobiptest"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const apiKey = "secret-api-key"
+
+func newTestClient(t *testing.T, baseURL string, recorder *Recorder) infobip.Client {
+	t.Helper()
+	client, err := infobip.NewClient(baseURL, apiKey, infobip.WithHTTPClient(recorder.HTTPClient()))
+	require.NoError(t, err)
+
+	return client
+}
+
+func sendSMS(t *testing.T, client infobip.Client, to ...string) models.SendSMSResponse {
+	t.Helper()
+	destinations := make([]models.SMSDestination, 0, len(to))
+	for _, number := range to {
+		destinations = append(destinations, models.SMSDestination{To: number})
+	}
+	resp, respDetails, err := client.SMS.Send(context.Background(), models.SendSMSRequest{
+		Messages: []models.SMSMsg{{From: "InfoSMS", Text: "Hello", Destinations: destinations}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+
+	return resp
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms.json")
+	server := infobiptest.NewServer(apiKey)
+
+	recorder, err := New(path, ModeRecord)
+	require.NoError(t, err)
+	recorded := sendSMS(t, newTestClient(t, server.URL, recorder), "41793026727", "41793026728")
+	require.NoError(t, recorder.Save())
+	server.Close()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), apiKey)
+	assert.NotContains(t, string(content), "41793026727")
+	assert.NotContains(t, string(content), "41793026728")
+	assert.Contains(t, string(content), "00000000001")
+
+	replayer, err := New(path, ModeReplay)
+	require.NoError(t, err)
+	replayed := sendSMS(t, newTestClient(t, server.URL, replayer), "41793026727", "41793026728")
+	assert.Equal(t, recorded, replayed)
+	assert.Equal(t, "41793026728", replayed.Messages[1].To)
+
+	_, _, err = newTestClient(t, server.URL, replayer).SMS.Send(context.Background(), models.SendSMSRequest{
+		Messages: []models.SMSMsg{{
+			From:         "InfoSMS",
+			Text:         "Hello",
+			Destinations: []models.SMSDestination{{To: "41793026727"}},
+		}},
+	})
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+}
+
+func TestReplayOtherNumbers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms.json")
+	server := infobiptest.NewServer(apiKey)
+	defer server.Close()
+
+	recorder, err := New(path, ModeRecord)
+	require.NoError(t, err)
+	sendSMS(t, newTestClient(t, server.URL, recorder), "41793026727")
+	require.NoError(t, recorder.Save())
+
+	replayer, err := New(path, ModeReplay)
+	require.NoError(t, err)
+	replayed := sendSMS(t, newTestClient(t, server.URL, replayer), "38598765432")
+	require.Len(t, replayed.Messages, 1)
+	assert.Equal(t, "38598765432", replayed.Messages[0].To)
+	assert.Len(t, server.Messages(), 1)
+}
+
+func TestReplayMultipartWithOtherBoundary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "email.json")
+	server := infobiptest.NewServer(apiKey)
+	msg := models.EmailMsg{From: "jane@example.com", To: "john@example.com", Subject: "Hello", Text: "Hi John"}
+
+	recorder, err := New(path, ModeRecord)
+	require.NoError(t, err)
+	recorded, _, err := newTestClient(t, server.URL, recorder).Email.Send(context.Background(), msg)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+	server.Close()
+
+	replayer, err := New(path, ModeReplay)
+	require.NoError(t, err)
+	replayed, respDetails, err := newTestClient(t, server.URL, replayer).Email.Send(context.Background(), msg)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, recorded, replayed)
+
+	msg.Subject = "Goodbye"
+	replayer, err = New(path, ModeReplay)
+	require.NoError(t, err)
+	_, _, err = newTestClient(t, server.URL, replayer).Email.Send(context.Background(), msg)
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+}
+
+func TestReplayQueryInAnyOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.json")
+	server := infobiptest.NewServer(apiKey)
+
+	recorder, err := New(path, ModeRecord)
+	require.NoError(t, err)
+	params := models.GetSMSLogsParams{From: "InfoSMS", To: "41793026727", Limit: 10}
+	_, respDetails, err := newTestClient(t, server.URL, recorder).SMS.GetLogs(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	require.NoError(t, recorder.Save())
+	server.Close()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "41793026727")
+
+	replayer, err := New(path, ModeReplay)
+	require.NoError(t, err)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet,
+		server.URL+"/sms/1/logs?to=41793026727&limit=10&from=InfoSMS", nil)
+	require.NoError(t, err)
+	resp, err := replayer.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestModeAuto(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "sms.json")
+	server := infobiptest.NewServer(apiKey)
+	defer server.Close()
+
+	recorder, err := New(path, ModeAuto)
+	require.NoError(t, err)
+	assert.Equal(t, ModeRecord, recorder.Mode())
+	sendSMS(t, newTestClient(t, server.URL, recorder), "41793026727")
+	require.NoError(t, recorder.Save())
+
+	replayer, err := New(path, ModeAuto)
+	require.NoError(t, err)
+	assert.Equal(t, ModeReplay, replayer.Mode())
+	sendSMS(t, newTestClient(t, server.URL, replayer), "41793026727")
+	assert.Len(t, server.Messages(), 1)
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
@@ -0,0 +1,232 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// nolint: gochecknoglobals // read-only
+var (
+	phoneNumberPattern = regexp.MustCompile(`^\+?[0-9]{8,15}$`)
+	// sensitiveHeaders are redacted from recorded requests and responses.
+	sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+)
+
+// scrubber replaces phone numbers with placeholders of the same length, numbered in the order the numbers are
+// seen, e.g. 41793026727 with 00000000001. Numbering placeholders in order, rather than hashing the numbers,
+// keeps the numbers from being recovered from a cassette, while a replay seeing the same requests in the same order
+// assigns the same placeholders, and can replace them back in the recorded responses.
+type scrubber struct {
+	placeholders map[string]string
+	numbers      map[string]string
+	count        int
+}
+
+func newScrubber() *scrubber {
+	return &scrubber{placeholders: map[string]string{}, numbers: map[string]string{}}
+}
+
+// value returns the placeholder of a phone number, or any other value unchanged.
+func (s *scrubber) value(value string) string {
+	if !phoneNumberPattern.MatchString(value) {
+		return value
+	}
+	if placeholder, ok := s.placeholders[value]; ok {
+		return placeholder
+	}
+
+	s.count++
+	digits := strings.TrimPrefix(value, "+")
+	placeholder := value[:len(value)-len(digits)] + fmt.Sprintf("%0*d", len(digits), s.count)
+	s.placeholders[value] = placeholder
+	s.numbers[placeholder] = value
+
+	return placeholder
+}
+
+// restore returns the phone number of a placeholder, or any other value unchanged. Placeholders assigned to numbers
+// which were first seen in responses are accounted for, so that the next numbers get the placeholders they had
+// when recording.
+func (s *scrubber) restore(value string) string {
+	if !phoneNumberPattern.MatchString(value) {
+		return value
+	}
+	if number, ok := s.numbers[value]; ok {
+		return number
+	}
+	if n, err := strconv.Atoi(strings.TrimPrefix(value, "+")); err == nil && n > s.count {
+		s.count = n
+	}
+
+	return value
+}
+
+func (s *scrubber) request(req *http.Request, body []byte) Request {
+	segments := strings.Split(req.URL.Path, "/")
+	for i, segment := range segments {
+		segments[i] = s.value(segment)
+	}
+	query := req.URL.Query()
+	for _, values := range query {
+		for i, value := range values {
+			values[i] = s.value(value)
+		}
+	}
+
+	return Request{
+		Method:  req.Method,
+		Path:    strings.Join(segments, "/"),
+		Query:   query.Encode(),
+		Headers: redactHeaders(req.Header),
+		Body:    s.body(req.Header.Get("Content-Type"), body, s.value),
+	}
+}
+
+func (s *scrubber) response(resp *http.Response, body []byte) Response {
+	headers := redactHeaders(resp.Header)
+	delete(headers, "Content-Length")
+
+	return Response{
+		StatusCode: resp.StatusCode,
+		Headers:    headers,
+		Body:       s.body(resp.Header.Get("Content-Type"), body, s.value),
+	}
+}
+
+type multipartPart struct {
+	Name     string `json:"name"`
+	Filename string `json:"filename,omitempty"`
+	Body     string `json:"body"`
+}
+
+// body normalizes a body, replacing its values with replace: the string values of JSON documents, the text parts
+// of multipart bodies, and text bodies consisting of a single value.
+func (s *scrubber) body(contentType string, body []byte, replace func(string) string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if normalized, err := s.multipart(body, params["boundary"], replace); err == nil {
+			return normalized
+		}
+	}
+
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err == nil && !decoder.More() {
+		switch document.(type) {
+		case map[string]interface{}, []interface{}:
+			normalized, _ := json.Marshal(replaceJSON(document, replace))
+			return string(normalized)
+		}
+	}
+
+	return replace(string(body))
+}
+
+func (s *scrubber) multipart(body []byte, boundary string, replace func(string) string) (string, error) {
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	parts := []multipartPart{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, multipartPart{
+			Name:     part.FormName(),
+			Filename: part.FileName(),
+			Body:     s.body(part.Header.Get("Content-Type"), content, replace),
+		})
+	}
+	normalized, err := json.Marshal(parts)
+
+	return string(normalized), err
+}
+
+// restoreBody replaces the placeholders of a recorded body with the phone numbers of the replayed requests.
+func (s *scrubber) restoreBody(contentType string, body string) string {
+	return s.body(contentType, []byte(body), s.restore)
+}
+
+func replaceJSON(value interface{}, replace func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return replace(v)
+	case []interface{}:
+		for i := range v {
+			v[i] = replaceJSON(v[i], replace)
+		}
+	case map[string]interface{}:
+		for key := range v {
+			v[key] = replaceJSON(v[key], replace)
+		}
+	}
+
+	return value
+}
+
+func redactHeaders(header http.Header) map[string][]string {
+	if len(header) == 0 {
+		return nil
+	}
+	headers := make(map[string][]string, len(header))
+	for name, values := range header {
+		headers[name] = append([]string{}, values...)
+	}
+	for _, name := range sensitiveHeaders {
+		if _, ok := headers[name]; ok {
+			headers[name] = []string{redacted}
+		}
+	}
+
+	return headers
+}
+
+// matches reports whether a recorded request matches a scrubbed one.
+func matches(recorded Request, req Request) bool {
+	return recorded.Method == req.Method && recorded.Path == req.Path && sameQuery(recorded.Query, req.Query) &&
+		recorded.Body == req.Body
+}
+
+func sameQuery(recorded string, query string) bool {
+	if recorded == query {
+		return true
+	}
+	a, errA := url.ParseQuery(recorded)
+	b, errB := url.ParseQuery(query)
+	if errA != nil || errB != nil || len(a) != len(b) {
+		return false
+	}
+	for key, values := range a {
+		other := append([]string{}, b[key]...)
+		values = append([]string{}, values...)
+		sort.Strings(values)
+		sort.Strings(other)
+		if strings.Join(values, "\x00") != strings.Join(other, "\x00") {
+			return false
+		}
+	}
+
+	return true
+}
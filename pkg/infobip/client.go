@@ -20,17 +20,34 @@ import (
 
 // Client is the entrypoint to all Infobip channels.
 type Client struct {
-	apiKey     string
-	baseURL    string
-	httpClient http.Client
-	WhatsApp   whatsapp.WhatsApp
-	MMS        mms.MMS
-	Email      email.Email
-	SMS        sms.SMS
-	WebRTC     webrtc.WebRTC
-	RCS        rcs.RCS
+	apiKey          string
+	baseURL         string
+	httpClient      http.Client
+	retryPolicy     RetryPolicy
+	rateLimiter     *internal.RateLimiter
+	middlewares     []Middleware
+	instrumentation Instrumentation
+	logger          Logger
+	authenticator   Authenticator
+	returnAPIErrors bool
+	WhatsApp        whatsapp.WhatsApp
+	MMS             mms.MMS
+	Email           email.Email
+	SMS             sms.SMS
+	WebRTC          webrtc.WebRTC
+	RCS             rcs.RCS
 }
 
+// Authenticator sets the credentials of a request. It is called before every attempt, so that it can refresh
+// short-lived tokens, and may use the request context to do so. See the auth package for implementations.
+type Authenticator = internal.Authenticator
+
+// RetryPolicy configures how requests that failed with a transport error, a 429 or a 5xx response are retried.
+type RetryPolicy = internal.RetryPolicy
+
+// PollPolicy configures how often the WaitForDelivery functions of the channels poll delivery reports.
+type PollPolicy = internal.PollPolicy
+
 // NewClientFromEnv returns a client object using the credentials from the environment.
 // If a client is not provided using options, a default one is created.
 func NewClientFromEnv(options ...func(*Client)) (Client, error) {
@@ -57,30 +74,52 @@ func NewClient(baseURL string, apiKey string, options ...func(*Client)) (Client,
 		opt(&c)
 	}
 
-	c.WhatsApp = &whatsapp.Channel{
-		ReqHandler: internal.HTTPHandler{APIKey: apiKey, BaseURL: baseURL, HTTPClient: c.httpClient},
-	}
-	c.MMS = &mms.Channel{
-		ReqHandler: internal.HTTPHandler{APIKey: apiKey, BaseURL: baseURL, HTTPClient: c.httpClient},
-	}
+	c.WhatsApp = &whatsapp.Channel{ReqHandler: c.newReqHandler()}
+	c.MMS = &mms.Channel{ReqHandler: c.newReqHandler()}
+	c.Email = &email.Channel{ReqHandler: c.newReqHandler()}
+	c.SMS = &sms.Channel{ReqHandler: c.newReqHandler()}
+	c.WebRTC = &webrtc.Channel{ReqHandler: c.newReqHandler()}
+	c.RCS = &rcs.Channel{ReqHandler: c.newReqHandler()}
 
-	c.Email = &email.Channel{
-		ReqHandler: internal.HTTPHandler{APIKey: apiKey, BaseURL: baseURL, HTTPClient: c.httpClient},
-	}
+	return c, nil
+}
 
-	c.SMS = &sms.Channel{
-		ReqHandler: internal.HTTPHandler{APIKey: apiKey, BaseURL: baseURL, HTTPClient: c.httpClient},
-	}
+// Channels are implementations of the channels of a client.
+type Channels struct {
+	WhatsApp whatsapp.WhatsApp
+	MMS      mms.MMS
+	Email    email.Email
+	SMS      sms.SMS
+	WebRTC   webrtc.WebRTC
+	RCS      rcs.RCS
+}
 
-	c.WebRTC = &webrtc.Channel{
-		ReqHandler: internal.HTTPHandler{APIKey: apiKey, BaseURL: baseURL, HTTPClient: c.httpClient},
+// NewClientWithChannels returns a client using the given channel implementations, e.g. the fakes of the mocks
+// package in unit tests. Channels left nil are not available.
+func NewClientWithChannels(channels Channels) Client {
+	return Client{
+		WhatsApp: channels.WhatsApp,
+		MMS:      channels.MMS,
+		Email:    channels.Email,
+		SMS:      channels.SMS,
+		WebRTC:   channels.WebRTC,
+		RCS:      channels.RCS,
 	}
+}
 
-	c.RCS = &rcs.Channel{
-		ReqHandler: internal.HTTPHandler{APIKey: apiKey, BaseURL: baseURL, HTTPClient: c.httpClient},
+func (c *Client) newReqHandler() internal.HTTPHandler {
+	return internal.HTTPHandler{
+		APIKey:          c.apiKey,
+		Authenticator:   c.authenticator,
+		BaseURL:         c.baseURL,
+		HTTPClient:      c.httpClient,
+		RetryPolicy:     c.retryPolicy,
+		RateLimiter:     c.rateLimiter,
+		Middlewares:     c.middlewares,
+		Instrumentation: c.instrumentation,
+		Logger:          c.logger,
+		ReturnAPIErrors: c.returnAPIErrors,
 	}
-
-	return c, nil
 }
 
 func validateURL(baseURL string) (string, error) {
@@ -98,3 +137,31 @@ func WithHTTPClient(httpClient http.Client) func(*Client) {
 		c.httpClient = httpClient
 	}
 }
+
+// WithAuthenticator makes the client authenticate requests with the given authenticator instead of the API key,
+// which can then be left empty. Authenticators caching tokens are asked to invalidate them when a request is
+// rejected with a 401 status code, if they implement an Invalidate() method.
+func WithAuthenticator(authenticator Authenticator) func(*Client) {
+	return func(c *Client) {
+		c.authenticator = authenticator
+	}
+}
+
+// WithRetryPolicy makes the client retry requests which failed with a transport error, a 429 or a 5xx response.
+// Retries wait with jittered exponential backoff, honor the Retry-After header and never outlive the
+// deadline of the request context.
+func WithRetryPolicy(policy RetryPolicy) func(*Client) {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// DefaultRetryPolicy returns a policy making up to three attempts with jittered exponential backoff.
+func DefaultRetryPolicy() RetryPolicy {
+	return internal.DefaultRetryPolicy()
+}
+
+// DefaultPollPolicy returns a policy polling after one second first, doubling the delay up to 30 seconds.
+func DefaultPollPolicy() PollPolicy {
+	return internal.DefaultPollPolicy()
+}
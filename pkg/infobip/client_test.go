@@ -1,12 +1,20 @@
 package infobip
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/email"
 	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/mms"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/rcs"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/sms"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/webrtc"
 	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/whatsapp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -63,3 +71,128 @@ func TestClientInvalidURL(t *testing.T) {
 	require.NotNil(t, err)
 	assert.Equal(t, Client{}, client)
 }
+
+func TestClientWithRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	policy.IdempotentOnly = true
+	client, err := NewClient("https://k31ke1.api.infobip.com", "secret", WithRetryPolicy(policy))
+	require.NoError(t, err)
+
+	assert.Equal(t, policy, client.WhatsApp.(*whatsapp.Channel).ReqHandler.RetryPolicy)
+	assert.Equal(t, policy, client.MMS.(*mms.Channel).ReqHandler.RetryPolicy)
+}
+
+func TestClientWithAPIErrors(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, servErr := w.Write([]byte(`{
+			"requestError": {"serviceException": {"messageId": "UNAUTHORIZED", "text": "Invalid login details"}}
+		}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	client, err := NewClient(serv.URL, "secret")
+	require.NoError(t, err)
+	_, respDetails, err := client.SMS.GetTFAApplications(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, respDetails.HTTPResponse.StatusCode)
+
+	client, err = NewClient(serv.URL, "secret", WithAPIErrors())
+	require.NoError(t, err)
+	_, respDetails, err = client.SMS.GetTFAApplications(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "UNAUTHORIZED", apiErr.MessageID)
+	assert.Equal(t, http.StatusUnauthorized, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestClientWithRateLimit(t *testing.T) {
+	client, err := NewClient(
+		"https://k31ke1.api.infobip.com",
+		"secret",
+		WithRateLimit(RateLimit{PathPrefix: "sms/", RequestsPerSecond: 10}, RateLimit{RequestsPerSecond: 100}),
+	)
+	require.NoError(t, err)
+
+	limiter := client.WhatsApp.(*whatsapp.Channel).ReqHandler.RateLimiter
+	require.NotNil(t, limiter)
+	assert.Same(t, limiter, client.MMS.(*mms.Channel).ReqHandler.RateLimiter)
+	assert.Same(t, limiter, client.Email.(*email.Channel).ReqHandler.RateLimiter)
+	assert.Same(t, limiter, client.SMS.(*sms.Channel).ReqHandler.RateLimiter)
+	assert.Same(t, limiter, client.WebRTC.(*webrtc.Channel).ReqHandler.RateLimiter)
+	assert.Same(t, limiter, client.RCS.(*rcs.Channel).ReqHandler.RateLimiter)
+}
+
+func TestClientWithMiddleware(t *testing.T) {
+	var headers []http.Header
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header)
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write([]byte(`{"messages": [{"messageId": "some-id"}]}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	var operations []Operation
+	recorder := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			operation, ok := OperationFromContext(req.Context())
+			assert.True(t, ok)
+			operations = append(operations, operation)
+			return next.Do(req)
+		})
+	}
+	client, err := NewClient(
+		serv.URL,
+		"secret",
+		WithMiddleware(recorder, HeaderMiddleware(http.Header{"X-Tenant": []string{"some-tenant"}})),
+		WithMiddleware(RequestIDMiddleware()),
+	)
+	require.NoError(t, err)
+
+	ctx := ContextWithRequestID(context.Background(), "some-request-id")
+	_, _, err = client.SMS.Send(ctx, models.SendSMSRequest{
+		Messages: []models.SMSMsg{{Destinations: []models.SMSDestination{{To: "41793026727"}}, Text: "Hi!"}},
+	})
+	require.NoError(t, err)
+	_, _, err = client.WhatsApp.GetTemplates(context.Background(), "111111111111")
+	require.NoError(t, err)
+
+	assert.Equal(t, []Operation{
+		{Name: "SMS.Send", PathTemplate: "sms/2/text/advanced"},
+		{Name: "WhatsApp.GetTemplates", PathTemplate: "whatsapp/2/senders/{sender}/templates"},
+	}, operations)
+	require.Len(t, headers, 2)
+	assert.Equal(t, "some-tenant", headers[0].Get("X-Tenant"))
+	assert.Equal(t, "App secret", headers[0].Get("Authorization"))
+	assert.Equal(t, "some-request-id", headers[0].Get("X-Request-Id"))
+	assert.Len(t, headers[1].Get("X-Request-Id"), 32)
+}
+
+type discardLogger struct{}
+
+func (discardLogger) Debug(string, ...interface{}) {}
+
+func TestClientWithLogger(t *testing.T) {
+	logger := discardLogger{}
+	client, err := NewClient("https://k31ke1.api.infobip.com", "secret", WithLogger(logger))
+	require.NoError(t, err)
+
+	assert.Equal(t, logger, client.SMS.(*sms.Channel).ReqHandler.Logger)
+	assert.Equal(t, logger, client.WebRTC.(*webrtc.Channel).ReqHandler.Logger)
+}
+
+func TestClientWithChannels(t *testing.T) {
+	smsChannel := &sms.Channel{}
+	rcsChannel := &rcs.Channel{}
+
+	client := NewClientWithChannels(Channels{SMS: smsChannel, RCS: rcsChannel})
+
+	assert.Same(t, smsChannel, client.SMS)
+	assert.Same(t, rcsChannel, client.RCS)
+	assert.Nil(t, client.WhatsApp)
+	assert.Nil(t, client.Email)
+}
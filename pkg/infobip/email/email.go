@@ -97,6 +97,8 @@ func (email *Channel) Send(
 	ctx context.Context,
 	msg models.EmailMsg,
 ) (msgResp models.SendEmailResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.Send", sendEmailPath)
+
 	respDetails, err = email.ReqHandler.PostMultipartReq(ctx, &msg, &msgResp, sendEmailPath)
 	return msgResp, respDetails, err
 }
@@ -105,6 +107,8 @@ func (email *Channel) GetDeliveryReports(
 	ctx context.Context,
 	queryParams models.GetEmailDeliveryReportsParams,
 ) (resp models.GetEmailDeliveryReportsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.GetDeliveryReports", getDeliveryReportsPath)
+
 	params := []internal.QueryParameter{
 		{Name: "bulkId", Value: queryParams.BulkID},
 		{Name: "messageId", Value: queryParams.MessageID},
@@ -121,6 +125,8 @@ func (email *Channel) GetLogs(
 	ctx context.Context,
 	queryParams models.GetEmailLogsParams,
 ) (resp models.GetEmailLogsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.GetLogs", getLogsPath)
+
 	params := []internal.QueryParameter{
 		{Name: "messageId", Value: queryParams.MessageID},
 		{Name: "from", Value: queryParams.From},
@@ -142,6 +148,8 @@ func (email *Channel) GetSentBulks(
 	ctx context.Context,
 	queryParams models.GetSentEmailBulksParams,
 ) (resp models.SentEmailBulksResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.GetSentBulks", getSentEmailBulksPath)
+
 	params := []internal.QueryParameter{{Name: "bulkId", Value: queryParams.BulkID}}
 	respDetails, err = email.ReqHandler.GetRequest(ctx, &resp, getSentEmailBulksPath, params)
 	return resp, respDetails, err
@@ -152,6 +160,8 @@ func (email *Channel) RescheduleMessages(
 	req models.RescheduleEmailRequest,
 	queryParams models.RescheduleEmailParams,
 ) (resp models.RescheduleEmailResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.RescheduleMessages", rescheduleMessagesPath)
+
 	params := []internal.QueryParameter{{Name: "bulkId", Value: queryParams.BulkID}}
 	respDetails, err = email.ReqHandler.PutJSONReq(ctx, &req, &resp, rescheduleMessagesPath, params)
 	return resp, respDetails, err
@@ -161,6 +171,8 @@ func (email *Channel) GetSentBulksStatus(
 	ctx context.Context,
 	queryParams models.GetSentEmailBulksStatusParams,
 ) (resp models.SentEmailBulksStatusResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.GetSentBulksStatus", getSentEmailBulksStatusPath)
+
 	params := []internal.QueryParameter{{Name: "bulkId", Value: queryParams.BulkID}}
 	respDetails, err = email.ReqHandler.GetRequest(ctx, &resp, getSentEmailBulksStatusPath, params)
 	return resp, respDetails, err
@@ -171,6 +183,8 @@ func (email *Channel) UpdateScheduledMessagesStatus(
 	req models.UpdateScheduledEmailStatusRequest,
 	queryParams models.UpdateScheduledEmailStatusParams,
 ) (resp models.UpdateScheduledStatusResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.UpdateScheduledMessagesStatus", updateScheduledMessagesStatusPath)
+
 	params := []internal.QueryParameter{{Name: "bulkId", Value: queryParams.BulkID}}
 	respDetails, err = email.ReqHandler.PutJSONReq(ctx, &req, &resp, updateScheduledMessagesStatusPath, params)
 	return resp, respDetails, err
@@ -180,6 +194,8 @@ func (email *Channel) ValidateAddresses(
 	ctx context.Context,
 	req models.ValidateEmailAddressesRequest,
 ) (resp models.ValidateEmailAddressesResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.ValidateAddresses", validateAddressesPath)
+
 	respDetails, err = email.ReqHandler.PostJSONReq(ctx, &req, &resp, validateAddressesPath)
 	return resp, respDetails, err
 }
@@ -188,6 +204,8 @@ func (email *Channel) GetDomains(
 	ctx context.Context,
 	queryParams models.GetEmailDomainsParams,
 ) (resp models.GetEmailDomainsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.GetDomains", getDomainsPath)
+
 	params := []internal.QueryParameter{
 		{Name: "size", Value: fmt.Sprint(queryParams.Size)},
 		{Name: "page", Value: fmt.Sprint(queryParams.Page)},
@@ -200,6 +218,8 @@ func (email *Channel) AddDomain(
 	ctx context.Context,
 	req models.AddEmailDomainRequest,
 ) (resp models.AddEmailDomainResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.AddDomain", addDomainPath)
+
 	respDetails, err = email.ReqHandler.PostJSONReq(ctx, &req, &resp, addDomainPath)
 	return resp, respDetails, err
 }
@@ -208,6 +228,8 @@ func (email *Channel) GetDomain(
 	ctx context.Context,
 	domainName string,
 ) (resp models.GetEmailDomainResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.GetDomain", "email/1/domains/{domainName}")
+
 	respDetails, err = email.ReqHandler.GetRequest(ctx, &resp, fmt.Sprint(getDomainPath, "/", domainName), nil)
 	return resp, respDetails, err
 }
@@ -216,6 +238,8 @@ func (email *Channel) DeleteDomain(
 	ctx context.Context,
 	domainName string,
 ) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.DeleteDomain", "email/1/domains/{domainName}")
+
 	respDetails, err = email.ReqHandler.DeleteRequest(ctx, fmt.Sprint(deleteDomainPath, "/", domainName), nil)
 	return respDetails, err
 }
@@ -225,6 +249,8 @@ func (email *Channel) UpdateDomainTracking(
 	domainName string,
 	req models.UpdateEmailDomainTrackingRequest,
 ) (resp models.UpdateEmailDomainTrackingResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.UpdateDomainTracking", "email/1/domains/{domainName}/tracking")
+
 	respDetails, err = email.ReqHandler.PutJSONReq(ctx, &req, &resp,
 		fmt.Sprint(updateDomainTrackingPath, "/", domainName, "/tracking"), nil)
 	return resp, respDetails, err
@@ -234,6 +260,8 @@ func (email *Channel) VerifyDomain(
 	ctx context.Context,
 	domainName string,
 ) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "Email.VerifyDomain", "email/1/domains/{domainName}/verify")
+
 	respDetails, err = email.ReqHandler.PostNoBodyReq(ctx, nil,
 		fmt.Sprint(verifyDomainPath, "/", domainName, "/verify"))
 	return respDetails, err
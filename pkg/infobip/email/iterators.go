@@ -0,0 +1,177 @@
+package email
+
+import (
+	"context"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// LogIterator iterates over the logs of sent emails, fetching pages of at most params.Limit logs and narrowing
+// the sentSince/sentUntil window after each page, so that all the logs matching params are returned:
+//
+//	it := email.NewLogIterator(ctx, client.Email, models.GetEmailLogsParams{SentSince: since, Limit: 1000}, 0)
+//	for it.Next() {
+//		export(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// Unsuccessful responses end the iteration with an *infobip.APIError.
+type LogIterator struct {
+	pager *internal.Pager
+	page  []models.EmailLog
+}
+
+// NewLogIterator creates a LogIterator returning at most maxResults logs, or all of them when maxResults is not
+// positive.
+func NewLogIterator(ctx context.Context, email Email, params models.GetEmailLogsParams, maxResults int) *LogIterator {
+	it := &LogIterator{}
+	window := internal.LogWindow{SentSince: params.SentSince, SentUntil: params.SentUntil}
+	it.pager = internal.NewPager(ctx, maxResults, func(ctx context.Context, _ int) (int, bool, error) {
+		params.SentSince, params.SentUntil = window.SentSince, window.SentUntil
+		resp, respDetails, err := email.GetLogs(ctx, params)
+		if err == nil {
+			err = internal.ResponseError(respDetails)
+		}
+		if err != nil {
+			return 0, false, err
+		}
+
+		sentAt := make([]string, len(resp.Results))
+		messageIDs := make([]string, len(resp.Results))
+		for i, log := range resp.Results {
+			sentAt[i], messageIDs[i] = log.SentAt, log.MessageID
+		}
+		fresh, more, err := window.Advance(sentAt, messageIDs)
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = it.page[:0]
+		for i, log := range resp.Results {
+			if fresh[i] {
+				it.page = append(it.page, log)
+			}
+		}
+
+		return len(it.page), more && internal.FullPage(len(resp.Results), params.Limit), nil
+	})
+
+	return it
+}
+
+// Next advances to the next log, fetching the next page when needed. It returns false once the iteration is over.
+func (it *LogIterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Value returns the current log.
+func (it *LogIterator) Value() models.EmailLog {
+	return it.page[it.pager.Index()]
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *LogIterator) Err() error {
+	return it.pager.Err()
+}
+
+// DeliveryReportIterator iterates over delivery reports, fetching pages of at most params.Limit reports until no
+// more are returned. As reports are returned only once, the reports it returns are not returned by
+// GetDeliveryReports afterwards, and no more reports than the maximum of the iteration are fetched.
+type DeliveryReportIterator struct {
+	pager *internal.Pager
+	page  []models.EmailDeliveryReport
+}
+
+// NewDeliveryReportIterator creates a DeliveryReportIterator returning at most maxResults reports, or all of them
+// when maxResults is not positive.
+func NewDeliveryReportIterator(
+	ctx context.Context,
+	email Email,
+	params models.GetEmailDeliveryReportsParams,
+	maxResults int,
+) *DeliveryReportIterator {
+	it := &DeliveryReportIterator{}
+	limit := params.Limit
+	it.pager = internal.NewPager(ctx, maxResults, func(ctx context.Context, remaining int) (int, bool, error) {
+		params.Limit = internal.PageLimit(limit, remaining)
+		resp, respDetails, err := email.GetDeliveryReports(ctx, params)
+		if err == nil {
+			err = internal.ResponseError(respDetails)
+		}
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = resp.Results
+
+		return len(it.page), internal.FullPage(len(it.page), params.Limit), nil
+	})
+
+	return it
+}
+
+// Next advances to the next report, fetching the next page when needed. It returns false once the iteration is
+// over.
+func (it *DeliveryReportIterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Value returns the current report.
+func (it *DeliveryReportIterator) Value() models.EmailDeliveryReport {
+	return it.page[it.pager.Index()]
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *DeliveryReportIterator) Err() error {
+	return it.pager.Err()
+}
+
+// DomainIterator iterates over the domains of the account, fetching pages of params.Size domains from params.Page.
+type DomainIterator struct {
+	pager *internal.Pager
+	page  []models.EmailDomain
+}
+
+// NewDomainIterator creates a DomainIterator returning at most maxResults domains, or all of them when maxResults
+// is not positive.
+func NewDomainIterator(
+	ctx context.Context,
+	email Email,
+	params models.GetEmailDomainsParams,
+	maxResults int,
+) *DomainIterator {
+	it := &DomainIterator{}
+	it.pager = internal.NewPager(ctx, maxResults, func(ctx context.Context, _ int) (int, bool, error) {
+		resp, respDetails, err := email.GetDomains(ctx, params)
+		if err == nil {
+			err = internal.ResponseError(respDetails)
+		}
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = resp.Results
+		more := resp.Paging.Page+1 < resp.Paging.TotalPages
+		params.Page = resp.Paging.Page + 1
+
+		return len(it.page), more, nil
+	})
+
+	return it
+}
+
+// Next advances to the next domain, fetching the next page when needed. It returns false once the iteration is
+// over.
+func (it *DomainIterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Value returns the current domain.
+func (it *DomainIterator) Value() models.EmailDomain {
+	return it.page[it.pager.Index()]
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *DomainIterator) Err() error {
+	return it.pager.Err()
+}
@@ -0,0 +1,99 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIteratorTestChannel(serv *httptest.Server) *Channel {
+	return &Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     "secret",
+	}}
+}
+
+func TestLogIteratorNewestFirst(t *testing.T) {
+	end := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	var logs []models.EmailLog
+	for i := 0; i < 5; i++ {
+		sentAt := end.Add(-time.Duration(i) * time.Second).Format(internal.LogTimeLayout)
+		logs = append(logs, models.EmailLog{MessageID: fmt.Sprint("message-", i), SentAt: sentAt})
+	}
+
+	var queries []string
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+getLogsPath, r.URL.Path)
+		query := r.URL.Query()
+		queries = append(queries, query.Get("sentUntil"))
+		limit, err := strconv.Atoi(query.Get("limit"))
+		require.NoError(t, err)
+
+		resp := models.GetEmailLogsResponse{Results: []models.EmailLog{}}
+		for _, log := range logs {
+			if len(resp.Results) < limit && (query.Get("sentUntil") == "" || log.SentAt <= query.Get("sentUntil")) {
+				resp.Results = append(resp.Results, log)
+			}
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer serv.Close()
+
+	it := NewLogIterator(context.Background(), newIteratorTestChannel(serv), models.GetEmailLogsParams{Limit: 2}, 0)
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Value().MessageID)
+	}
+
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"message-0", "message-1", "message-2", "message-3", "message-4"}, ids)
+	assert.Equal(t, []string{"", logs[1].SentAt, logs[2].SentAt, logs[3].SentAt, logs[4].SentAt}, queries)
+}
+
+func TestDomainIterator(t *testing.T) {
+	var pages []string
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+getDomainsPath, r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("size"))
+		pages = append(pages, r.URL.Query().Get("page"))
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		require.NoError(t, err)
+
+		var resp models.GetEmailDomainsResponse
+		resp.Paging.Page, resp.Paging.Size, resp.Paging.TotalPages, resp.Paging.TotalResults = page, 2, 3, 5
+		for i := page * 2; i < 5 && i < page*2+2; i++ {
+			resp.Results = append(resp.Results, models.EmailDomain{DomainName: fmt.Sprintf("%d.example.com", i)})
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer serv.Close()
+	channel := newIteratorTestChannel(serv)
+
+	it := NewDomainIterator(context.Background(), channel, models.GetEmailDomainsParams{Size: 2}, 0)
+	var names []string
+	for it.Next() {
+		names = append(names, it.Value().DomainName)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{
+		"0.example.com", "1.example.com", "2.example.com", "3.example.com", "4.example.com",
+	}, names)
+	assert.Equal(t, []string{"0", "1", "2"}, pages)
+
+	it = NewDomainIterator(context.Background(), channel, models.GetEmailDomainsParams{Size: 2, Page: 1}, 1)
+	require.True(t, it.Next())
+	assert.Equal(t, "2.example.com", it.Value().DomainName)
+	assert.False(t, it.Next())
+	require.NoError(t, it.Err())
+}
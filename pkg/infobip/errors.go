@@ -0,0 +1,30 @@
+package infobip
+
+import "github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+
+// APIError describes a non-2xx response returned by the Infobip API. It is only returned by clients created
+// with the WithAPIErrors option. Use errors.As to inspect it, or errors.Is with one of the sentinel errors below.
+type APIError = internal.APIError
+
+var (
+	// ErrBadRequest matches APIErrors with a 400 status code.
+	ErrBadRequest = internal.ErrBadRequest
+	// ErrUnauthorized matches APIErrors with a 401 status code.
+	ErrUnauthorized = internal.ErrUnauthorized
+	// ErrForbidden matches APIErrors with a 403 status code.
+	ErrForbidden = internal.ErrForbidden
+	// ErrNotFound matches APIErrors with a 404 status code.
+	ErrNotFound = internal.ErrNotFound
+	// ErrRateLimited matches APIErrors with a 429 status code.
+	ErrRateLimited = internal.ErrRateLimited
+	// ErrServer matches APIErrors with a 5xx status code.
+	ErrServer = internal.ErrServer
+)
+
+// WithAPIErrors makes every channel method return an *APIError when the server responds with a non-2xx status
+// code. Without this option, such responses are only reported through ResponseDetails, and the error is nil.
+func WithAPIErrors() func(*Client) {
+	return func(c *Client) {
+		c.returnAPIErrors = true
+	}
+}
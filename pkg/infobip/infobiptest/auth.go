@@ -0,0 +1,149 @@
+package infobiptest
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+const (
+	schemeAPIKey = "App"
+	schemeBasic  = "Basic"
+	schemeIBSSO  = "IBSSO"
+	schemeBearer = "Bearer"
+
+	tokenLength        = 32
+	oauth2TokenExpires = 3600
+)
+
+type account struct {
+	username string
+	password string
+}
+
+// WithAccount makes the server accept the username and password of an account, with Basic authentication and to
+// create IBSSO sessions.
+func WithAccount(username string, password string) func(*Server) {
+	return func(s *Server) {
+		s.account = &account{username: username, password: password}
+	}
+}
+
+// WithOAuth2Client makes the server issue OAuth2 access tokens to the client with the given ID and secret.
+func WithOAuth2Client(clientID string, clientSecret string) func(*Server) {
+	return func(s *Server) {
+		s.oauth2Client = &account{username: clientID, password: clientSecret}
+	}
+}
+
+// RevokeTokens revokes the IBSSO and OAuth2 tokens issued so far, so that requests authenticated with them are
+// rejected with a 401 status code.
+func (s *Server) RevokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens = map[string]string{}
+}
+
+func (s *Server) registerAuthRoutes() {
+	s.handle(http.MethodPost, "auth/1/session", s.createIBSSOSession)
+	s.handle(http.MethodDelete, "auth/1/session", s.deleteIBSSOSession)
+	s.handle(http.MethodPost, "auth/1/oauth2/token", s.issueOAuth2Token)
+}
+
+// authenticated reports whether a request carries the API key, the credentials of the account, or a token issued
+// by the server. Requests creating sessions and tokens are authenticated by their body.
+func (s *Server) authenticated(r *http.Request) bool {
+	if r.Method == http.MethodPost {
+		switch requestPath(r) {
+		case "auth/1/session", "auth/1/oauth2/token":
+			return true
+		}
+	}
+
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return false
+	}
+	scheme, credentials := parts[0], parts[1]
+	switch scheme {
+	case schemeAPIKey:
+		return s.apiKey != "" && credentials == s.apiKey
+	case schemeBasic:
+		decoded, err := base64.StdEncoding.DecodeString(credentials)
+		if err != nil {
+			return false
+		}
+		userPass := strings.SplitN(string(decoded), ":", 2)
+		return len(userPass) == 2 && s.account.matches(userPass[0], userPass[1])
+	case schemeIBSSO, schemeBearer:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.tokens[credentials] == scheme
+	default:
+		return false
+	}
+}
+
+func (s *Server) createIBSSOSession(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if !s.account.matches(req.Username, req.Password) {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid login details")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"token": s.issueToken(schemeIBSSO)})
+}
+
+func (s *Server) deleteIBSSOSession(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), schemeIBSSO+" ")
+
+	s.mu.Lock()
+	delete(s.tokens, token)
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) issueOAuth2Token(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+		return
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Unsupported grant type")
+		return
+	}
+	if !s.oauth2Client.matches(r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")) {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid client credentials")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": s.issueToken(schemeBearer),
+		"token_type":   schemeBearer,
+		"expires_in":   oauth2TokenExpires,
+	})
+}
+
+func (s *Server) issueToken(scheme string) string {
+	token := generatePIN(models.HEX, tokenLength)
+
+	s.mu.Lock()
+	s.tokens[token] = scheme
+	s.mu.Unlock()
+
+	return token
+}
+
+func (a *account) matches(username string, password string) bool {
+	return a != nil && a.username == username && a.password == password
+}
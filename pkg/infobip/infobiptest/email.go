@@ -0,0 +1,275 @@
+package infobiptest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+const (
+	maxMultipartMemory    = 32 << 20
+	defaultDomainsPerPage = 10
+)
+
+func (s *Server) registerEmailRoutes() {
+	s.handle(http.MethodPost, "email/2/send", s.sendEmail)
+	s.handle(http.MethodGet, "email/1/reports", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		s.serveReports(w, r, ChannelEmail)
+	})
+	s.handle(http.MethodGet, "email/1/logs", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		s.serveLogs(w, r, ChannelEmail)
+	})
+	s.handle(http.MethodGet, "email/1/bulks", s.getEmailBulk)
+	s.handle(http.MethodPut, "email/1/bulks", s.rescheduleEmailBulk)
+	s.handle(http.MethodGet, "email/1/bulks/status", s.getEmailBulkStatus)
+	s.handle(http.MethodPut, "email/1/bulks/status", s.updateEmailBulkStatus)
+	s.handle(http.MethodPost, "email/2/validation", validateEmailAddress)
+	s.handle(http.MethodGet, "email/1/domains", s.getEmailDomains)
+	s.handle(http.MethodPost, "email/1/domains", s.addEmailDomain)
+	s.handle(http.MethodGet, "email/1/domains/{domain}", s.getEmailDomain)
+	s.handle(http.MethodDelete, "email/1/domains/{domain}", s.deleteEmailDomain)
+	s.handle(http.MethodPut, "email/1/domains/{domain}/tracking", s.updateEmailDomainTracking)
+	s.handle(http.MethodPost, "email/1/domains/{domain}/verify", s.verifyEmailDomain)
+}
+
+func (s *Server) sendEmail(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if err := r.ParseMultipartForm(maxMultipartMemory); err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+		return
+	}
+	form := r.MultipartForm.Value
+	payload, _ := json.Marshal(form)
+	text := r.FormValue("text")
+	if text == "" {
+		text = r.FormValue("HTML")
+	}
+
+	s.mu.Lock()
+	resp := sendResponse{BulkID: r.FormValue("bulkId"), Messages: []sentMessage{}}
+	if resp.BulkID == "" {
+		resp.BulkID = s.newID("bulk")
+	}
+	for i, to := range form["to"] {
+		messageID := ""
+		if i == 0 {
+			messageID = r.FormValue("messageId")
+		}
+		stored := s.store(Message{
+			Channel:      ChannelEmail,
+			BulkID:       resp.BulkID,
+			MessageID:    messageID,
+			From:         r.FormValue("from"),
+			To:           to,
+			Text:         text,
+			CallbackData: r.FormValue("callbackData"),
+			SendAt:       r.FormValue("sendAt"),
+			Payload:      payload,
+			Path:         requestPath(r),
+		})
+		resp.Messages = append(resp.Messages, sentMessage{
+			To: stored.To, MessageCount: 1, MessageID: stored.MessageID, Status: statusOf(StatusPending),
+		})
+	}
+	s.schedule(ChannelEmail, resp.BulkID, r.FormValue("sendAt"))
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+type emailBulk struct {
+	BulkID string `json:"bulkId"`
+	SendAt int64  `json:"sendAt,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type emailBulks struct {
+	ExternalBulkID string      `json:"externalBulkId"`
+	Bulks          []emailBulk `json:"bulks"`
+}
+
+func (s *Server) getEmailBulk(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	s.serveBulk(w, r, ChannelEmail, func(bulkID string, bulk *scheduledBulk) interface{} {
+		return emailBulks{ExternalBulkID: bulkID, Bulks: []emailBulk{{BulkID: bulkID, SendAt: sendAtMillis(bulk.sendAt)}}}
+	})
+}
+
+func (s *Server) rescheduleEmailBulk(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req models.RescheduleEmailRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if _, ok := parseTime(req.SendAt); !ok {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Invalid sendAt: "+req.SendAt)
+		return
+	}
+
+	s.serveBulk(w, r, ChannelEmail, func(bulkID string, bulk *scheduledBulk) interface{} {
+		bulk.sendAt = req.SendAt
+		return emailBulk{BulkID: bulkID, SendAt: sendAtMillis(bulk.sendAt)}
+	})
+}
+
+func (s *Server) getEmailBulkStatus(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	s.serveBulk(w, r, ChannelEmail, func(bulkID string, bulk *scheduledBulk) interface{} {
+		return emailBulks{ExternalBulkID: bulkID, Bulks: []emailBulk{{BulkID: bulkID, Status: bulk.status}}}
+	})
+}
+
+func (s *Server) updateEmailBulkStatus(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req models.UpdateScheduledEmailStatusRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.serveBulk(w, r, ChannelEmail, func(bulkID string, bulk *scheduledBulk) interface{} {
+		bulk.status = req.Status
+		return emailBulk{BulkID: bulkID, Status: bulk.status}
+	})
+}
+
+// validateEmailAddress checks the syntax of an address only. Mailboxes with a valid syntax are reported valid.
+func validateEmailAddress(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req models.ValidateEmailAddressesRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	_, err := mail.ParseAddress(req.To)
+	writeJSON(w, http.StatusOK, models.ValidateEmailAddressesResponse{
+		To:           req.To,
+		ValidMailbox: strconv.FormatBool(err == nil),
+		ValidSyntax:  err == nil,
+	})
+}
+
+func (s *Server) getEmailDomains(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	query := r.URL.Query()
+	size, err := strconv.Atoi(query.Get("size"))
+	if err != nil || size < 1 {
+		size = defaultDomainsPerPage
+	}
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 0 {
+		page = 0
+	}
+
+	s.mu.Lock()
+	var resp models.GetEmailDomainsResponse
+	resp.Paging.Page = page
+	resp.Paging.Size = size
+	resp.Paging.TotalResults = len(s.domains)
+	resp.Paging.TotalPages = (len(s.domains) + size - 1) / size
+	resp.Results = []models.EmailDomain{}
+	for i := page * size; i < len(s.domains) && i < (page+1)*size; i++ {
+		resp.Results = append(resp.Results, s.domains[i])
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) addEmailDomain(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req models.AddEmailDomainRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	exists := s.findEmailDomain(req.DomainName) != nil
+	domain := models.EmailDomain{
+		DomainID:   int64(len(s.domains) + 1),
+		DomainName: req.DomainName,
+		CreatedAt:  formatTime(time.Now()),
+	}
+	if !exists {
+		s.domains = append(s.domains, domain)
+	}
+	s.mu.Unlock()
+
+	if exists {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Domain already exists: "+req.DomainName)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain)
+}
+
+func (s *Server) getEmailDomain(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.updateEmailDomain(w, params["domain"], http.StatusOK, func(*models.EmailDomain) {})
+}
+
+func (s *Server) deleteEmailDomain(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	var kept []models.EmailDomain
+	for _, domain := range s.domains {
+		if domain.DomainName != params["domain"] {
+			kept = append(kept, domain)
+		}
+	}
+	deleted := len(kept) < len(s.domains)
+	s.domains = kept
+	s.mu.Unlock()
+
+	if !deleted {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Domain not found: "+params["domain"])
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updateEmailDomainTracking(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req models.UpdateEmailDomainTrackingRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.updateEmailDomain(w, params["domain"], http.StatusOK, func(domain *models.EmailDomain) {
+		domain.Tracking.Opens = req.Opens
+		domain.Tracking.Clicks = req.Clicks
+		domain.Tracking.Unsubscribe = req.Unsubscribe
+	})
+}
+
+// verifyEmailDomain activates a domain, as if its DNS records were verified.
+func (s *Server) verifyEmailDomain(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.updateEmailDomain(w, params["domain"], http.StatusAccepted, func(domain *models.EmailDomain) {
+		domain.Active = true
+	})
+}
+
+// updateEmailDomain updates a domain and responds with it, or with 404 when there is no such domain.
+func (s *Server) updateEmailDomain(
+	w http.ResponseWriter,
+	domainName string,
+	statusCode int,
+	update func(domain *models.EmailDomain),
+) {
+	s.mu.Lock()
+	var domain models.EmailDomain
+	found := s.findEmailDomain(domainName)
+	if found != nil {
+		update(found)
+		domain = *found
+	}
+	s.mu.Unlock()
+
+	if found == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Domain not found: "+domainName)
+		return
+	}
+	writeJSON(w, statusCode, domain)
+}
+
+// findEmailDomain returns a domain by name. It has to be called with the lock held.
+func (s *Server) findEmailDomain(domainName string) *models.EmailDomain {
+	for i := range s.domains {
+		if s.domains[i].DomainName == domainName {
+			return &s.domains[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package infobiptest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendEmailAndGetReports(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	resp, respDetails, err := client.Email.Send(ctx, models.EmailMsg{
+		From:    "Jane <jane@example.com>",
+		To:      "john@example.com",
+		Subject: "Hello",
+		Text:    "Hi John",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	require.Len(t, resp.Messages, 1)
+	assert.Equal(t, "john@example.com", resp.Messages[0].To)
+	assert.NotEmpty(t, resp.Messages[0].MessageID)
+
+	msgs := server.Messages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, ChannelEmail, msgs[0].Channel)
+	assert.Equal(t, "Hi John", msgs[0].Text)
+	assert.Contains(t, string(msgs[0].Payload), `"subject":["Hello"]`)
+
+	reports, _, err := client.Email.GetDeliveryReports(ctx, models.GetEmailDeliveryReportsParams{BulkID: resp.BulkID})
+	require.NoError(t, err)
+	require.Len(t, reports.Results, 1)
+	assert.Equal(t, StatusDelivered, reports.Results[0].Status.GroupName)
+	assert.Equal(t, "EMAIL", reports.Results[0].Channel)
+
+	logs, _, err := client.Email.GetLogs(ctx, models.GetEmailLogsParams{MessageID: resp.Messages[0].MessageID})
+	require.NoError(t, err)
+	require.Len(t, logs.Results, 1)
+	assert.Equal(t, "Hi John", logs.Results[0].Text)
+}
+
+func TestEmailScheduledBulks(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	sendAt := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+
+	_, _, err := client.Email.Send(ctx, models.EmailMsg{
+		From:    "jane@example.com",
+		To:      "john@example.com",
+		Subject: "Later",
+		Text:    "Hi John",
+		BulkID:  "scheduled",
+		SendAt:  sendAt.Format(timeLayout),
+	})
+	require.NoError(t, err)
+
+	bulks, _, err := client.Email.GetSentBulks(ctx, models.GetSentEmailBulksParams{BulkID: "scheduled"})
+	require.NoError(t, err)
+	require.Len(t, bulks.Bulks, 1)
+	assert.Equal(t, sendAt.UnixNano()/int64(time.Millisecond), bulks.Bulks[0].SendAt)
+
+	newSendAt := sendAt.Add(time.Hour)
+	rescheduled, _, err := client.Email.RescheduleMessages(ctx, models.RescheduleEmailRequest{
+		SendAt: newSendAt.Format(timeLayout),
+	}, models.RescheduleEmailParams{BulkID: "scheduled"})
+	require.NoError(t, err)
+	assert.Equal(t, newSendAt.UnixNano()/int64(time.Millisecond), rescheduled.SendAt)
+
+	updated, _, err := client.Email.UpdateScheduledMessagesStatus(ctx, models.UpdateScheduledEmailStatusRequest{
+		Status: "PAUSED",
+	}, models.UpdateScheduledEmailStatusParams{BulkID: "scheduled"})
+	require.NoError(t, err)
+	assert.Equal(t, "PAUSED", updated.Status)
+
+	status, _, err := client.Email.GetSentBulksStatus(ctx, models.GetSentEmailBulksStatusParams{BulkID: "scheduled"})
+	require.NoError(t, err)
+	require.Len(t, status.Bulks, 1)
+	assert.Equal(t, "PAUSED", status.Bulks[0].Status)
+}
+
+func TestValidateEmailAddresses(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	valid, _, err := client.Email.ValidateAddresses(context.Background(),
+		models.ValidateEmailAddressesRequest{To: "john@example.com"})
+	require.NoError(t, err)
+	assert.True(t, valid.ValidSyntax)
+
+	invalid, _, err := client.Email.ValidateAddresses(context.Background(),
+		models.ValidateEmailAddressesRequest{To: "not an address"})
+	require.NoError(t, err)
+	assert.False(t, invalid.ValidSyntax)
+	assert.Equal(t, "false", invalid.ValidMailbox)
+}
+
+func TestEmailDomains(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	for _, name := range []string{"a.example.com", "b.example.com", "c.example.com"} {
+		domain, _, err := client.Email.AddDomain(ctx, models.AddEmailDomainRequest{DomainName: name})
+		require.NoError(t, err)
+		assert.Equal(t, name, domain.DomainName)
+		assert.False(t, domain.Active)
+	}
+	_, respDetails, err := client.Email.AddDomain(ctx, models.AddEmailDomainRequest{DomainName: "a.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, respDetails.HTTPResponse.StatusCode)
+
+	page, _, err := client.Email.GetDomains(ctx, models.GetEmailDomainsParams{Size: 2, Page: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 3, page.Paging.TotalResults)
+	assert.Equal(t, 2, page.Paging.TotalPages)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, "c.example.com", page.Results[0].DomainName)
+
+	respDetails, err = client.Email.VerifyDomain(ctx, "b.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, respDetails.HTTPResponse.StatusCode)
+	tracked, _, err := client.Email.UpdateDomainTracking(ctx, "b.example.com",
+		models.UpdateEmailDomainTrackingRequest{Opens: true})
+	require.NoError(t, err)
+	assert.True(t, tracked.Tracking.Opens)
+
+	domain, _, err := client.Email.GetDomain(ctx, "b.example.com")
+	require.NoError(t, err)
+	assert.True(t, domain.Active)
+	assert.True(t, domain.Tracking.Opens)
+
+	respDetails, err = client.Email.DeleteDomain(ctx, "b.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+	_, respDetails, err = client.Email.GetDomain(ctx, "b.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+}
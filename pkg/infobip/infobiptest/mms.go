@@ -0,0 +1,80 @@
+package infobiptest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+func (s *Server) registerMMSRoutes() {
+	s.handle(http.MethodPost, "mms/1/single", s.sendMMS)
+	s.handle(http.MethodGet, "mms/1/reports", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		s.serveReports(w, r, ChannelMMS)
+	})
+	s.handle(http.MethodGet, "mms/1/inbox/reports", s.getInboundMMS)
+}
+
+func (s *Server) sendMMS(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if err := r.ParseMultipartForm(maxMultipartMemory); err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+		return
+	}
+	var head models.MMSHead
+	if err := json.Unmarshal([]byte(r.FormValue("head")), &head); err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: invalid head: "+err.Error())
+		return
+	}
+	payload, _ := json.Marshal(r.MultipartForm.Value)
+
+	s.mu.Lock()
+	stored := s.store(Message{
+		Channel:      ChannelMMS,
+		BulkID:       s.newID("bulk"),
+		MessageID:    head.ID,
+		From:         head.From,
+		To:           head.To,
+		Text:         r.FormValue("text"),
+		CallbackData: head.CallbackData,
+		SendAt:       head.SendAt,
+		Payload:      payload,
+		Path:         requestPath(r),
+	})
+	resp := models.SendMMSResponse{
+		BulkID:   stored.BulkID,
+		Messages: []models.SentMMS{{To: stored.To, Status: mmsStatus(statusOf(StatusPending)), MessageID: stored.MessageID}},
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) getInboundMMS(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	msgs, _ := s.takeInbound(ChannelMMS, limitParam(r.URL.Query(), defaultReportsLimit))
+
+	resp := models.GetInboundMMSResponse{Results: make([]models.InboundMMSResult, 0, len(msgs))}
+	for _, msg := range msgs {
+		resp.Results = append(resp.Results, models.InboundMMSResult{
+			MessageID:    msg.MessageID,
+			To:           msg.To,
+			From:         msg.From,
+			Message:      msg.Text,
+			ReceivedAt:   formatTime(msg.ReceivedAt),
+			MMSCount:     1,
+			CallbackData: msg.CallbackData,
+			Price:        models.MMSPrice{PricePerMessage: pricePerMessage, Currency: priceCurrency},
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func mmsStatus(st status) models.MMSStatus {
+	return models.MMSStatus{
+		GroupID:     int32(st.GroupID),
+		GroupName:   st.GroupName,
+		ID:          int32(st.ID),
+		Name:        st.Name,
+		Description: st.Description,
+	}
+}
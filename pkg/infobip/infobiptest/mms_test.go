@@ -0,0 +1,57 @@
+package infobiptest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendMMSAndGetReports(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	resp, respDetails, err := client.MMS.Send(ctx, models.MMSMsg{
+		Head: models.MMSHead{From: "InfoMMS", To: "41793026727", CallbackData: "data"},
+		Text: "Hello",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	require.Len(t, resp.Messages, 1)
+	assert.NotEmpty(t, resp.Messages[0].MessageID)
+	assert.Equal(t, StatusPending, resp.Messages[0].Status.GroupName)
+
+	msgs := server.Messages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, ChannelMMS, msgs[0].Channel)
+	assert.Equal(t, "Hello", msgs[0].Text)
+
+	reports, _, err := client.MMS.GetDeliveryReports(ctx, models.GetMMSDeliveryReportsParams{
+		MessageID: resp.Messages[0].MessageID,
+	})
+	require.NoError(t, err)
+	require.Len(t, reports.Results, 1)
+	assert.Equal(t, int32(1), reports.Results[0].MMSCount)
+	assert.Equal(t, "data", reports.Results[0].CallbackData)
+}
+
+func TestGetInboundMMS(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	server.AddInbound(InboundMessage{Channel: ChannelMMS, From: "41793026727", To: "InfoMMS", Text: "Picture"})
+
+	inbound, _, err := client.MMS.GetInboundMessages(context.Background(), models.GetInboundMMSParams{})
+	require.NoError(t, err)
+	require.Len(t, inbound.Results, 1)
+	assert.Equal(t, "Picture", inbound.Results[0].Message)
+
+	inbound, _, err = client.MMS.GetInboundMessages(context.Background(), models.GetInboundMMSParams{})
+	require.NoError(t, err)
+	assert.Empty(t, inbound.Results)
+}
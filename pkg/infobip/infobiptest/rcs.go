@@ -0,0 +1,89 @@
+package infobiptest
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type rcsMsg struct {
+	From         string `json:"from"`
+	To           string `json:"to"`
+	CallbackData string `json:"callbackData"`
+	MessageID    string `json:"messageId"`
+	Content      struct {
+		Text string `json:"text"`
+	} `json:"content"`
+}
+
+func (s *Server) registerRCSRoutes() {
+	s.handle(http.MethodPost, "ott/rcs/1/message", s.sendRCS)
+	s.handle(http.MethodPost, "ott/rcs/1/message/bulk", s.sendRCSBulk)
+	s.handle(http.MethodGet, "ott/rcs/1/reports", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		s.serveReports(w, r, ChannelRCS)
+	})
+}
+
+func (s *Server) sendRCS(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var payload json.RawMessage
+	if !readJSON(w, r, &payload) {
+		return
+	}
+	var msg rcsMsg
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	resp := s.storeRCSMsg(r, msg, payload)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) sendRCSBulk(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req struct {
+		Messages []json.RawMessage `json:"messages"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	msgs := make([]rcsMsg, 0, len(req.Messages))
+	for _, raw := range req.Messages {
+		var msg rcsMsg
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+			return
+		}
+		msgs = append(msgs, msg)
+	}
+
+	s.mu.Lock()
+	resp := make([]sendResponse, 0, len(msgs))
+	for i, msg := range msgs {
+		resp = append(resp, s.storeRCSMsg(r, msg, req.Messages[i]))
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// storeRCSMsg stores an RCS message and returns the response to its sending. It has to be called with the lock
+// held.
+func (s *Server) storeRCSMsg(r *http.Request, msg rcsMsg, payload json.RawMessage) sendResponse {
+	stored := s.store(Message{
+		Channel:      ChannelRCS,
+		MessageID:    msg.MessageID,
+		From:         msg.From,
+		To:           msg.To,
+		Text:         msg.Content.Text,
+		CallbackData: msg.CallbackData,
+		Payload:      payload,
+		Path:         requestPath(r),
+	})
+
+	return sendResponse{Messages: []sentMessage{{
+		To: stored.To, MessageCount: 1, MessageID: stored.MessageID, Status: statusOf(StatusPending),
+	}}}
+}
@@ -0,0 +1,71 @@
+package infobiptest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/rcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendRCS(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	resp, respDetails, err := client.RCS.Send(ctx, models.RCSMsg{
+		From: "InfoRCS", To: "41793026727", Content: &models.RCSContent{Type: "TEXT", Text: "Hello"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	require.Len(t, resp.Messages, 1)
+	assert.NotEmpty(t, resp.Messages[0].MessageID)
+
+	bulk, _, err := client.RCS.SendBulk(ctx, models.SendRCSBulkRequest{Messages: []models.RCSMsg{
+		{To: "41793026728", Content: &models.RCSContent{Type: "TEXT", Text: "One"}},
+		{To: "41793026729", MessageID: "custom-id", Content: &models.RCSContent{Type: "TEXT", Text: "Two"}},
+	}})
+	require.NoError(t, err)
+	require.Len(t, bulk, 2)
+	assert.Equal(t, "custom-id", bulk[1].Messages[0].MessageID)
+
+	msgs := server.Messages()
+	require.Len(t, msgs, 3)
+	assert.Equal(t, ChannelRCS, msgs[0].Channel)
+	assert.Equal(t, "Hello", msgs[0].Text)
+	assert.Equal(t, "ott/rcs/1/message/bulk", msgs[2].Path)
+}
+
+func TestWaitForRCSDelivery(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	server.SetDeliveryStatus(StatusPending)
+	bulk, _, err := client.RCS.SendBulk(ctx, models.SendRCSBulkRequest{Messages: []models.RCSMsg{
+		{To: "41793026728", Content: &models.RCSContent{Type: "TEXT", Text: "One"}},
+		{To: "41793026729", MessageID: "custom-id", Content: &models.RCSContent{Type: "TEXT", Text: "Two"}},
+	}})
+	require.NoError(t, err)
+	messageIDs := bulk.MessageIDs()
+	require.Len(t, messageIDs, 2)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		assert.NoError(t, server.SetStatus(messageIDs[0], StatusDelivered))
+		assert.NoError(t, server.SetStatus("custom-id", StatusRejected))
+	}()
+	policy := infobip.PollPolicy{InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond, Multiplier: 2}
+	reports, err := rcs.WaitForDelivery(ctx, client.RCS, messageIDs, policy)
+	require.NoError(t, err)
+	assert.Equal(t, StatusDelivered, reports[messageIDs[0]].Status.GroupName)
+	assert.Equal(t, StatusRejected, reports["custom-id"].Status.GroupName)
+	assert.Equal(t, "EC_ABSENT_SUBSCRIBER", reports["custom-id"].Error.Name)
+}
@@ -0,0 +1,265 @@
+package infobiptest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	defaultReportsLimit = 50
+	pricePerMessage     = 0.01
+	priceCurrency       = "EUR"
+)
+
+type status struct {
+	GroupID     int    `json:"groupId"`
+	GroupName   string `json:"groupName"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Action      string `json:"action,omitempty"`
+}
+
+type statusError struct {
+	GroupID     int    `json:"groupId"`
+	GroupName   string `json:"groupName"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Permanent   bool   `json:"permanent"`
+}
+
+type price struct {
+	PricePerMessage float64 `json:"pricePerMessage"`
+	Currency        string  `json:"currency"`
+}
+
+// report is a delivery report or a log of a message. The count of messages is named after the channel.
+type report struct {
+	BulkID       string      `json:"bulkId,omitempty"`
+	MessageID    string      `json:"messageId"`
+	To           string      `json:"to"`
+	From         string      `json:"from,omitempty"`
+	Text         string      `json:"text,omitempty"`
+	SentAt       string      `json:"sentAt"`
+	DoneAt       string      `json:"doneAt,omitempty"`
+	SMSCount     int         `json:"smsCount,omitempty"`
+	MMSCount     int         `json:"mmsCount,omitempty"`
+	MessageCount int         `json:"messageCount,omitempty"`
+	CallbackData string      `json:"callbackData,omitempty"`
+	Price        price       `json:"price"`
+	Status       status      `json:"status"`
+	Error        statusError `json:"error"`
+	Channel      string      `json:"channel,omitempty"`
+}
+
+type reportsResponse struct {
+	Results []report `json:"results"`
+}
+
+// nolint: gochecknoglobals // read-only
+var statuses = map[string]status{
+	StatusPending: {
+		GroupID: 1, GroupName: StatusPending, ID: 7, Name: "PENDING_ENROUTE",
+		Description: "Message sent to next instance",
+	},
+	StatusUndeliverable: {
+		GroupID: 2, GroupName: StatusUndeliverable, ID: 9, Name: "UNDELIVERABLE_NOT_DELIVERED",
+		Description: "Message sent not delivered",
+	},
+	StatusDelivered: {
+		GroupID: 3, GroupName: StatusDelivered, ID: 5, Name: "DELIVERED_TO_HANDSET",
+		Description: "Message delivered to handset",
+	},
+	StatusExpired: {
+		GroupID: 4, GroupName: StatusExpired, ID: 15, Name: "EXPIRED_EXPIRED",
+		Description: "Message expired",
+	},
+	StatusRejected: {
+		GroupID: 5, GroupName: StatusRejected, ID: 6, Name: "REJECTED_NETWORK",
+		Description: "Network is forbidden",
+	},
+}
+
+// statusOf returns the status of a status group, defaulting to PENDING for unknown groups.
+func statusOf(group string) status {
+	if s, ok := statuses[group]; ok {
+		return s
+	}
+
+	return statuses[StatusPending]
+}
+
+func errorOf(group string) statusError {
+	switch group {
+	case StatusPending, StatusDelivered:
+		return statusError{GroupName: "OK", Name: "NO_ERROR", Description: "No Error"}
+	default:
+		return statusError{
+			GroupID: 1, GroupName: "HANDSET_ERRORS", ID: 27, Name: "EC_ABSENT_SUBSCRIBER",
+			Description: "Absent Subscriber", Permanent: true,
+		}
+	}
+}
+
+func newReport(msg *Message) report {
+	r := report{
+		BulkID:       msg.BulkID,
+		MessageID:    msg.MessageID,
+		To:           msg.To,
+		From:         msg.From,
+		SentAt:       formatTime(msg.SentAt),
+		CallbackData: msg.CallbackData,
+		Price:        price{PricePerMessage: pricePerMessage, Currency: priceCurrency},
+		Status:       statusOf(msg.Status),
+		Error:        errorOf(msg.Status),
+	}
+	if msg.Status != StatusPending {
+		r.DoneAt = formatTime(msg.SentAt)
+	}
+	switch msg.Channel {
+	case ChannelSMS:
+		r.SMSCount = 1
+	case ChannelMMS:
+		r.MMSCount = 1
+	case ChannelEmail:
+		r.MessageCount = 1
+		r.Channel = string(ChannelEmail)
+	default:
+		r.MessageCount = 1
+	}
+
+	return r
+}
+
+// serveReports serves the delivery reports of a channel. Each report is served once, like the real API does,
+// and messages with the PENDING status have no report.
+func (s *Server) serveReports(w http.ResponseWriter, r *http.Request, channel Channel) {
+	query := r.URL.Query()
+	limit := limitParam(query, defaultReportsLimit)
+
+	s.mu.Lock()
+	results := []report{}
+	for _, msg := range s.messages {
+		if len(results) >= limit {
+			break
+		}
+		if msg.Channel != channel || msg.reported || msg.Status == StatusPending || !matchIDs(msg, query) {
+			continue
+		}
+		msg.reported = true
+		results = append(results, newReport(msg))
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, reportsResponse{Results: results})
+}
+
+// serveLogs serves the logs of the messages of a channel, filtered by the query parameters of the logs routes.
+func (s *Server) serveLogs(w http.ResponseWriter, r *http.Request, channel Channel) {
+	query := r.URL.Query()
+	limit := limitParam(query, defaultReportsLimit)
+	since, hasSince := parseTime(query.Get("sentSince"))
+	until, hasUntil := parseTime(query.Get("sentUntil"))
+
+	s.mu.Lock()
+	results := []report{}
+	for _, msg := range s.messages {
+		if len(results) >= limit {
+			break
+		}
+		switch {
+		case msg.Channel != channel, !matchIDs(msg, query),
+			query.Get("from") != "" && query.Get("from") != msg.From,
+			query.Get("to") != "" && query.Get("to") != msg.To,
+			query.Get("generalStatus") != "" && query.Get("generalStatus") != msg.Status,
+			hasSince && msg.SentAt.Before(since),
+			hasUntil && msg.SentAt.After(until):
+			continue
+		}
+		log := newReport(msg)
+		log.Text = msg.Text
+		log.CallbackData = ""
+		results = append(results, log)
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, reportsResponse{Results: results})
+}
+
+// matchIDs reports whether a message matches the bulkId and messageId query parameters, which may be repeated.
+func matchIDs(msg *Message, query url.Values) bool {
+	return matchAny(query["bulkId"], msg.BulkID) && matchAny(query["messageId"], msg.MessageID)
+}
+
+func matchAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func limitParam(query url.Values, defaultLimit int) int {
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit < 1 {
+		return defaultLimit
+	}
+
+	return limit
+}
+
+// scheduledBulk is a bulk of messages sent with a sendAt time.
+type scheduledBulk struct {
+	sendAt string
+	status string
+}
+
+// schedule records a bulk of messages sent with a sendAt time. It has to be called with the lock held.
+func (s *Server) schedule(channel Channel, bulkID string, sendAt string) {
+	if sendAt == "" || bulkID == "" {
+		return
+	}
+	s.bulks[string(channel)+"/"+bulkID] = &scheduledBulk{sendAt: sendAt, status: StatusPending}
+}
+
+// serveBulk serves the scheduled bulk of the bulkId query parameter with the response returned by serve, which
+// is called with the lock held and may update the bulk. It responds with 404 when there is no such bulk.
+func (s *Server) serveBulk(
+	w http.ResponseWriter,
+	r *http.Request,
+	channel Channel,
+	serve func(bulkID string, bulk *scheduledBulk) interface{},
+) {
+	bulkID := r.URL.Query().Get("bulkId")
+
+	s.mu.Lock()
+	bulk, ok := s.bulks[string(channel)+"/"+bulkID]
+	var resp interface{}
+	if ok {
+		resp = serve(bulkID, bulk)
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Bulk not found: "+bulkID)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// sendAtMillis converts a sendAt time to milliseconds since the epoch, as used by the Email API.
+func sendAtMillis(sendAt string) int64 {
+	t, ok := parseTime(sendAt)
+	if !ok {
+		return 0
+	}
+
+	return millis(t)
+}
@@ -0,0 +1,446 @@
+// Package infobiptest provides a fake, in-memory Infobip API for integration tests of code using this SDK.
+//
+// The fake serves the SMS, 2FA, WhatsApp, Email, MMS, RCS and WebRTC routes of the SDK. It stores the messages it
+// is sent, assigns them message and bulk IDs, and serves delivery reports and logs for them, so that a client
+// created with the URL of the server behaves as it would against the real API:
+//
+//	server := infobiptest.NewServer("secret")
+//	defer server.Close()
+//	client, err := infobip.NewClient(server.URL, "secret")
+//
+// Besides API keys, the server accepts Basic credentials, and issues IBSSO and OAuth2 tokens, for the account and
+// client configured with WithAccount and WithOAuth2Client, so that clients using the authenticators of the auth
+// package can be tested too.
+package infobiptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// Channel is the channel a message was sent or received through.
+type Channel string
+
+const (
+	ChannelSMS      Channel = "SMS"
+	ChannelWhatsApp Channel = "WHATSAPP"
+	ChannelEmail    Channel = "EMAIL"
+	ChannelMMS      Channel = "MMS"
+	ChannelRCS      Channel = "RCS"
+)
+
+// Status groups of messages, as reported in delivery reports and logs.
+const (
+	StatusPending       = "PENDING"
+	StatusUndeliverable = "UNDELIVERABLE"
+	StatusDelivered     = "DELIVERED"
+	StatusExpired       = "EXPIRED"
+	StatusRejected      = "REJECTED"
+)
+
+const timeLayout = "2006-01-02T15:04:05.000-0700"
+
+// Message is a message sent to the server.
+type Message struct {
+	Channel      Channel
+	BulkID       string
+	MessageID    string
+	From         string
+	To           string
+	Text         string
+	CallbackData string
+	SendAt       string
+	SentAt       time.Time
+	// Status is the status group of the message, e.g. DELIVERED. It can be changed with SetStatus.
+	Status string
+	// Payload is the JSON of the message as it was sent. Multipart messages are converted to a JSON object with
+	// the values of their text parts.
+	Payload json.RawMessage
+	// Path is the path of the request which sent the message, e.g. "sms/2/text/advanced".
+	Path     string
+	reported bool
+}
+
+// InboundMessage is a message received from a user, served by the inbound messages routes of its channel.
+type InboundMessage struct {
+	Channel      Channel
+	MessageID    string
+	From         string
+	To           string
+	Text         string
+	Keyword      string
+	CallbackData string
+	ReceivedAt   time.Time
+}
+
+// Fault makes the requests to a route fail or slow down.
+type Fault struct {
+	// StatusCode, when set, is the status of the response, which is sent instead of serving the request.
+	StatusCode int
+	// Body is the body of the response. It defaults to a service exception matching StatusCode.
+	Body []byte
+	// Latency delays the response, or the serving of the request when no StatusCode is set.
+	Latency time.Duration
+	// Times is the number of requests affected by the fault. Values below 1 affect requests until ClearFaults.
+	Times int
+}
+
+type faultRule struct {
+	method  string
+	pattern string
+	fault   Fault
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params map[string]string)
+
+type route struct {
+	method  string
+	pattern string
+	handle  handlerFunc
+}
+
+// Server is a fake Infobip API. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, to create clients with.
+	URL    string
+	apiKey string
+	server *httptest.Server
+	routes []route
+	// account and oauth2Client are the credentials accepted besides the API key, if any.
+	account      *account
+	oauth2Client *account
+
+	mu             sync.Mutex
+	nextID         int
+	deliveryStatus string
+	faults         []*faultRule
+	messages       []*Message
+	inbound        []InboundMessage
+	bulks          map[string]*scheduledBulk
+	tfa            tfaState
+	templates      map[string][]models.CreateWATemplateResponse
+	domains        []models.EmailDomain
+	webRTCApps     []models.WebRTCApplication
+	whatsApp       waState
+	// tokens maps the IBSSO and OAuth2 tokens issued by the server to their scheme.
+	tokens map[string]string
+}
+
+// NewServer starts a server accepting requests authenticated with the given API key, and with the credentials
+// configured by options, such as WithAccount. An empty API key is never accepted. The server has to be closed with
+// Close when no longer needed.
+func NewServer(apiKey string, options ...func(*Server)) *Server {
+	s := &Server{
+		apiKey:         apiKey,
+		deliveryStatus: StatusDelivered,
+		bulks:          map[string]*scheduledBulk{},
+		tfa:            newTFAState(),
+		templates:      map[string][]models.CreateWATemplateResponse{},
+		whatsApp:       newWAState(),
+		tokens:         map[string]string{},
+	}
+	for _, option := range options {
+		option(s)
+	}
+	s.registerAuthRoutes()
+	s.registerSMSRoutes()
+	s.registerTFARoutes()
+	s.registerWhatsAppRoutes()
+	s.registerEmailRoutes()
+	s.registerMMSRoutes()
+	s.registerRCSRoutes()
+	s.registerWebRTCRoutes()
+	s.server = httptest.NewServer(s)
+	s.URL = s.server.URL
+
+	return s
+}
+
+// Close shuts the server down, blocking until all requests are done.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// SetDeliveryStatus sets the status group of the messages sent from now on, DELIVERED by default. Messages with
+// the PENDING status have no delivery report until their status is changed with SetStatus.
+func (s *Server) SetDeliveryStatus(status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deliveryStatus = status
+}
+
+// SetStatus changes the status group of a message, making its delivery report available again.
+func (s *Server) SetStatus(messageID string, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, msg := range s.messages {
+		if msg.MessageID == messageID {
+			msg.Status = status
+			msg.reported = false
+			return nil
+		}
+	}
+
+	return fmt.Errorf("infobiptest: unknown message %q", messageID)
+}
+
+// Messages returns the messages sent to the server, in the order they were sent.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	msgs := make([]Message, 0, len(s.messages))
+	for _, msg := range s.messages {
+		msgs = append(msgs, *msg)
+	}
+
+	return msgs
+}
+
+// AddInbound queues a message received from a user. SMS and MMS messages are served once by the inbound messages
+// routes of their channel.
+func (s *Server) AddInbound(msg InboundMessage) InboundMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if msg.MessageID == "" {
+		msg.MessageID = s.newID("inbound")
+	}
+	if msg.ReceivedAt.IsZero() {
+		msg.ReceivedAt = time.Now()
+	}
+	s.inbound = append(s.inbound, msg)
+
+	return msg
+}
+
+// InjectFault makes requests matching method and path fail or slow down. An empty method matches any method, and
+// the path may contain parameters, e.g. "2fa/2/applications/{appId}". Faults are matched in the order they were
+// injected.
+func (s *Server) InjectFault(method string, path string, fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &faultRule{method: method, pattern: strings.Trim(path, "/"), fault: fault})
+}
+
+// ClearFaults removes all the injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authenticated(r) {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid login details")
+		return
+	}
+
+	path := requestPath(r)
+	if fault, ok := s.takeFault(r.Method, path); ok {
+		if !sleep(r, fault.Latency) {
+			return
+		}
+		if fault.StatusCode != 0 {
+			writeFault(w, fault)
+			return
+		}
+	}
+
+	methodAllowed := false
+	for _, rt := range s.routes {
+		params, ok := matchPath(rt.pattern, path)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			methodAllowed = true
+			continue
+		}
+		rt.handle(w, r, params)
+		return
+	}
+
+	if methodAllowed {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Method not allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "Requested URL not found: "+r.URL.Path)
+}
+
+func (s *Server) handle(method string, pattern string, handle handlerFunc) {
+	s.routes = append(s.routes, route{method: method, pattern: pattern, handle: handle})
+}
+
+func (s *Server) takeFault(method string, path string) (Fault, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, rule := range s.faults {
+		if rule.method != "" && rule.method != method {
+			continue
+		}
+		if _, ok := matchPath(rule.pattern, path); !ok {
+			continue
+		}
+		if rule.fault.Times > 0 {
+			rule.fault.Times--
+			if rule.fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return rule.fault, true
+	}
+
+	return Fault{}, false
+}
+
+// newID returns a new unique ID. It has to be called with the lock held.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%d", prefix, s.nextID)
+}
+
+// store saves a message, assigning it an ID when it has none. It has to be called with the lock held.
+func (s *Server) store(msg Message) *Message {
+	if msg.MessageID == "" {
+		msg.MessageID = s.newID("message")
+	}
+	msg.SentAt = time.Now()
+	msg.Status = s.deliveryStatus
+	s.messages = append(s.messages, &msg)
+
+	return &msg
+}
+
+// takeInbound removes and returns up to limit inbound messages of a channel, and the number of messages left.
+func (s *Server) takeInbound(channel Channel, limit int) (taken []InboundMessage, pending int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var kept []InboundMessage
+	for _, msg := range s.inbound {
+		if msg.Channel != channel || (limit > 0 && len(taken) >= limit) {
+			if msg.Channel == channel {
+				pending++
+			}
+			kept = append(kept, msg)
+			continue
+		}
+		taken = append(taken, msg)
+	}
+	s.inbound = kept
+
+	return taken, pending
+}
+
+func requestPath(r *http.Request) string {
+	return strings.Trim(r.URL.Path, "/")
+}
+
+// matchPath matches a path against a pattern, whose segments in braces match any value, e.g. {appId}.
+func matchPath(pattern string, path string) (map[string]string, bool) {
+	patternSegments := strings.Split(pattern, "/")
+	pathSegments := strings.Split(path, "/")
+	if len(patternSegments) != len(pathSegments) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = pathSegments[i]
+			continue
+		}
+		if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// sleep waits for the given duration, and reports whether the request is still waited on.
+func sleep(r *http.Request, duration time.Duration) bool {
+	if duration <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-r.Context().Done():
+		return false
+	}
+}
+
+type serviceException struct {
+	RequestError struct {
+		ServiceException struct {
+			MessageID string `json:"messageId"`
+			Text      string `json:"text"`
+		} `json:"serviceException"`
+	} `json:"requestError"`
+}
+
+func writeError(w http.ResponseWriter, statusCode int, messageID string, text string) {
+	var body serviceException
+	body.RequestError.ServiceException.MessageID = messageID
+	body.RequestError.ServiceException.Text = text
+	writeJSON(w, statusCode, body)
+}
+
+func writeFault(w http.ResponseWriter, fault Fault) {
+	if fault.Body == nil {
+		messageID := strings.ToUpper(strings.ReplaceAll(http.StatusText(fault.StatusCode), " ", "_"))
+		writeError(w, fault.StatusCode, messageID, http.StatusText(fault.StatusCode))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(fault.StatusCode)
+	_, _ = w.Write(fault.Body)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// readJSON decodes the body of a request, responding with 400 when it is not valid JSON.
+func readJSON(w http.ResponseWriter, r *http.Request, target interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(timeLayout)
+}
+
+func parseTime(value string) (time.Time, bool) {
+	for _, layout := range []string{timeLayout, time.RFC3339Nano} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
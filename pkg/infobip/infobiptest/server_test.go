@@ -0,0 +1,201 @@
+package infobiptest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/auth"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const apiKey = "secret"
+
+func newTestClient(t *testing.T, server *Server) infobip.Client {
+	t.Helper()
+	client, err := infobip.NewClient(server.URL, apiKey)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestServerRejectsInvalidAPIKey(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client, err := infobip.NewClient(server.URL, "wrong")
+	require.NoError(t, err)
+
+	_, respDetails, err := client.SMS.GetLogs(context.Background(), models.GetSMSLogsParams{})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "UNAUTHORIZED", respDetails.ErrorResponse.RequestError.ServiceException.MessageID)
+}
+
+func TestServerAuthenticators(t *testing.T) {
+	server := NewServer(apiKey, WithAccount("user", "password"), WithOAuth2Client("client", "client-secret"))
+	defer server.Close()
+
+	tests := map[string]infobip.Authenticator{
+		"API key": auth.APIKey(apiKey),
+		"Basic":   auth.Basic{Username: "user", Password: "password"},
+		"IBSSO":   &auth.IBSSO{BaseURL: server.URL, Username: "user", Password: "password"},
+		"OAuth2": &auth.OAuth2ClientCredentials{
+			BaseURL:      server.URL,
+			ClientID:     "client",
+			ClientSecret: "client-secret",
+		},
+	}
+	for name, authenticator := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := infobip.NewClient(server.URL, "", infobip.WithAuthenticator(authenticator))
+			require.NoError(t, err)
+
+			_, respDetails, err := client.SMS.GetLogs(context.Background(), models.GetSMSLogsParams{})
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+		})
+	}
+}
+
+func TestServerRejectsInvalidCredentials(t *testing.T) {
+	server := NewServer(apiKey, WithAccount("user", "password"), WithOAuth2Client("client", "client-secret"))
+	defer server.Close()
+	ctx := context.Background()
+
+	client, err := infobip.NewClient(
+		server.URL, "", infobip.WithAuthenticator(auth.Basic{Username: "user", Password: "wrong"}))
+	require.NoError(t, err)
+	_, respDetails, err := client.SMS.GetLogs(ctx, models.GetSMSLogsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, respDetails.HTTPResponse.StatusCode)
+
+	// Sessions and tokens are not issued for invalid credentials, which fails requests before they are sent.
+	tests := map[string]infobip.Authenticator{
+		"IBSSO":  &auth.IBSSO{BaseURL: server.URL, Username: "user", Password: "wrong"},
+		"OAuth2": &auth.OAuth2ClientCredentials{BaseURL: server.URL, ClientID: "client", ClientSecret: "wrong"},
+	}
+	for name, authenticator := range tests {
+		t.Run(name, func(t *testing.T) {
+			tokenClient, clientErr := infobip.NewClient(server.URL, "", infobip.WithAuthenticator(authenticator))
+			require.NoError(t, clientErr)
+
+			_, _, clientErr = tokenClient.SMS.GetLogs(ctx, models.GetSMSLogsParams{})
+			var apiErr *infobip.APIError
+			require.ErrorAs(t, clientErr, &apiErr)
+			assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+		})
+	}
+}
+
+func TestServerRevokeTokens(t *testing.T) {
+	server := NewServer("", WithOAuth2Client("client", "client-secret"))
+	defer server.Close()
+	authenticator := &auth.OAuth2ClientCredentials{
+		BaseURL:      server.URL,
+		ClientID:     "client",
+		ClientSecret: "client-secret",
+	}
+	client, err := infobip.NewClient(server.URL, "", infobip.WithAuthenticator(authenticator))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, respDetails, err := client.SMS.GetLogs(ctx, models.GetSMSLogsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+
+	server.RevokeTokens()
+	_, respDetails, err = client.SMS.GetLogs(ctx, models.GetSMSLogsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, respDetails.HTTPResponse.StatusCode)
+	// The rejected token is invalidated, so that the next request gets a new one.
+	_, respDetails, err = client.SMS.GetLogs(ctx, models.GetSMSLogsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+
+	apiKeyClient, err := infobip.NewClient(server.URL, "")
+	require.NoError(t, err)
+	_, respDetails, err = apiKeyClient.SMS.GetLogs(ctx, models.GetSMSLogsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestServerUnknownRoute(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/sms/1/unknown", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "App "+apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestServerInjectFault(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	server.InjectFault(http.MethodGet, "sms/1/logs", Fault{StatusCode: http.StatusServiceUnavailable, Times: 2})
+
+	for i := 0; i < 2; i++ {
+		_, respDetails, err := client.SMS.GetLogs(context.Background(), models.GetSMSLogsParams{})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, respDetails.HTTPResponse.StatusCode)
+		assert.Equal(t, "SERVICE_UNAVAILABLE", respDetails.ErrorResponse.RequestError.ServiceException.MessageID)
+	}
+
+	_, respDetails, err := client.SMS.GetLogs(context.Background(), models.GetSMSLogsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestServerInjectFaultWithParams(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	server.InjectFault("", "2fa/2/applications/{appId}", Fault{
+		StatusCode: http.StatusBadRequest,
+		Body:       []byte(`{"requestError":{"serviceException":{"messageId":"BAD_REQUEST","text":"Custom"}}}`),
+	})
+
+	_, respDetails, err := client.SMS.GetTFAApplication(context.Background(), "some-app")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "Custom", respDetails.ErrorResponse.RequestError.ServiceException.Text)
+
+	_, respDetails, err = client.SMS.GetTFAApplications(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+
+	server.ClearFaults()
+	_, respDetails, err = client.SMS.GetTFAApplication(context.Background(), "some-app")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestServerInjectLatency(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	latency := 50 * time.Millisecond
+	server.InjectFault(http.MethodGet, "sms/1/logs", Fault{Latency: latency, Times: 1})
+
+	start := time.Now()
+	_, respDetails, err := client.SMS.GetLogs(context.Background(), models.GetSMSLogsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), latency)
+
+	ctx, cancel := context.WithTimeout(context.Background(), latency)
+	defer cancel()
+	server.InjectFault(http.MethodGet, "sms/1/logs", Fault{Latency: time.Minute})
+	_, _, err = client.SMS.GetLogs(ctx, models.GetSMSLogsParams{})
+	assert.Error(t, err)
+}
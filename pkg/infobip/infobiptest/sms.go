@@ -0,0 +1,242 @@
+package infobiptest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/smsencoding"
+)
+
+type smsMsg struct {
+	CallbackData string `json:"callbackData"`
+	Destinations []struct {
+		MessageID string `json:"messageId"`
+		To        string `json:"to"`
+	} `json:"destinations"`
+	From   string `json:"from"`
+	SendAt string `json:"sendAt"`
+	Text   string `json:"text"`
+	Binary *struct {
+		Hex string `json:"hex"`
+	} `json:"binary"`
+}
+
+type sentMessage struct {
+	To           string `json:"to"`
+	MessageCount int    `json:"messageCount,omitempty"`
+	MessageID    string `json:"messageId"`
+	Status       status `json:"status"`
+}
+
+type sendResponse struct {
+	BulkID   string        `json:"bulkId,omitempty"`
+	Messages []sentMessage `json:"messages"`
+}
+
+type inboundSMS struct {
+	CallbackData string `json:"callbackData"`
+	CleanText    string `json:"cleanText"`
+	From         string `json:"from"`
+	Keyword      string `json:"keyword"`
+	MessageID    string `json:"messageId"`
+	Price        price  `json:"price"`
+	ReceivedAt   string `json:"receivedAt"`
+	SMSCount     int    `json:"smsCount"`
+	Text         string `json:"text"`
+	To           string `json:"to"`
+}
+
+func (s *Server) registerSMSRoutes() {
+	s.handle(http.MethodPost, "sms/2/text/advanced", s.sendSMS)
+	s.handle(http.MethodPost, "sms/2/binary/advanced", s.sendSMS)
+	s.handle(http.MethodGet, "sms/1/text/query", s.sendSMSOverQueryParams)
+	s.handle(http.MethodPost, "sms/1/preview", previewSMS)
+	s.handle(http.MethodGet, "sms/1/reports", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		s.serveReports(w, r, ChannelSMS)
+	})
+	s.handle(http.MethodGet, "sms/1/logs", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		s.serveLogs(w, r, ChannelSMS)
+	})
+	s.handle(http.MethodGet, "sms/1/inbox/reports", s.getInboundSMS)
+	s.handle(http.MethodGet, "sms/1/bulks", s.getSMSBulk)
+	s.handle(http.MethodPut, "sms/1/bulks", s.rescheduleSMSBulk)
+	s.handle(http.MethodGet, "sms/1/bulks/status", s.getSMSBulkStatus)
+	s.handle(http.MethodPut, "sms/1/bulks/status", s.updateSMSBulkStatus)
+}
+
+func (s *Server) sendSMS(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req struct {
+		BulkID   string            `json:"bulkId"`
+		Messages []json.RawMessage `json:"messages"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	msgs := make([]smsMsg, 0, len(req.Messages))
+	for _, raw := range req.Messages {
+		var msg smsMsg
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+			return
+		}
+		msgs = append(msgs, msg)
+	}
+
+	s.mu.Lock()
+	resp := sendResponse{BulkID: req.BulkID, Messages: []sentMessage{}}
+	if resp.BulkID == "" {
+		resp.BulkID = s.newID("bulk")
+	}
+	for i, msg := range msgs {
+		text := msg.Text
+		if msg.Binary != nil {
+			text = msg.Binary.Hex
+		}
+		for _, destination := range msg.Destinations {
+			stored := s.store(Message{
+				Channel:      ChannelSMS,
+				BulkID:       resp.BulkID,
+				MessageID:    destination.MessageID,
+				From:         msg.From,
+				To:           destination.To,
+				Text:         text,
+				CallbackData: msg.CallbackData,
+				SendAt:       msg.SendAt,
+				Payload:      req.Messages[i],
+				Path:         requestPath(r),
+			})
+			resp.Messages = append(resp.Messages, sentMessage{
+				To: stored.To, MessageID: stored.MessageID, Status: statusOf(StatusPending),
+			})
+		}
+		s.schedule(ChannelSMS, resp.BulkID, msg.SendAt)
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) sendSMSOverQueryParams(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	query := r.URL.Query()
+	payload, _ := json.Marshal(query)
+
+	s.mu.Lock()
+	resp := sendResponse{BulkID: query.Get("bulkId"), Messages: []sentMessage{}}
+	if resp.BulkID == "" {
+		resp.BulkID = s.newID("bulk")
+	}
+	for _, to := range query["to"] {
+		stored := s.store(Message{
+			Channel:      ChannelSMS,
+			BulkID:       resp.BulkID,
+			From:         query.Get("from"),
+			To:           to,
+			Text:         query.Get("text"),
+			CallbackData: query.Get("callbackData"),
+			SendAt:       query.Get("sendAt"),
+			Payload:      payload,
+			Path:         requestPath(r),
+		})
+		resp.Messages = append(resp.Messages, sentMessage{
+			To: stored.To, MessageID: stored.MessageID, Status: statusOf(StatusPending),
+		})
+	}
+	s.schedule(ChannelSMS, resp.BulkID, query.Get("sendAt"))
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// previewSMS previews a text with the offline calculator of the smsencoding package.
+func previewSMS(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req models.PreviewSMSRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	resp, err := smsencoding.Preview(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) getInboundSMS(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	msgs, pending := s.takeInbound(ChannelSMS, limitParam(r.URL.Query(), defaultReportsLimit))
+
+	results := make([]inboundSMS, 0, len(msgs))
+	for _, msg := range msgs {
+		results = append(results, inboundSMS{
+			CallbackData: msg.CallbackData,
+			CleanText:    msg.Text,
+			From:         msg.From,
+			Keyword:      msg.Keyword,
+			MessageID:    msg.MessageID,
+			Price:        price{PricePerMessage: pricePerMessage, Currency: priceCurrency},
+			ReceivedAt:   formatTime(msg.ReceivedAt),
+			SMSCount:     1,
+			Text:         msg.Text,
+			To:           msg.To,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, struct {
+		MessageCount        int          `json:"messageCount"`
+		PendingMessageCount int          `json:"pendingMessageCount"`
+		Results             []inboundSMS `json:"results"`
+	}{MessageCount: len(results), PendingMessageCount: pending, Results: results})
+}
+
+type smsBulk struct {
+	BulkID string `json:"bulkId"`
+	SendAt string `json:"sendAt,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+func (s *Server) getSMSBulk(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	s.serveBulk(w, r, ChannelSMS, func(bulkID string, bulk *scheduledBulk) interface{} {
+		return smsBulk{BulkID: bulkID, SendAt: bulk.sendAt}
+	})
+}
+
+func (s *Server) rescheduleSMSBulk(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req struct {
+		SendAt string `json:"sendAt"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if _, ok := parseTime(req.SendAt); !ok {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Invalid sendAt: "+req.SendAt)
+		return
+	}
+
+	s.serveBulk(w, r, ChannelSMS, func(bulkID string, bulk *scheduledBulk) interface{} {
+		bulk.sendAt = req.SendAt
+		return smsBulk{BulkID: bulkID, SendAt: bulk.sendAt}
+	})
+}
+
+func (s *Server) getSMSBulkStatus(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	s.serveBulk(w, r, ChannelSMS, func(bulkID string, bulk *scheduledBulk) interface{} {
+		return smsBulk{BulkID: bulkID, Status: bulk.status}
+	})
+}
+
+func (s *Server) updateSMSBulkStatus(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req struct {
+		Status string `json:"status"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.serveBulk(w, r, ChannelSMS, func(bulkID string, bulk *scheduledBulk) interface{} {
+		bulk.status = req.Status
+		return smsBulk{BulkID: bulkID, Status: bulk.status}
+	})
+}
@@ -0,0 +1,185 @@
+package infobiptest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendSMSAndGetReports(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	resp, respDetails, err := client.SMS.Send(ctx, models.SendSMSRequest{
+		Messages: []models.SMSMsg{{
+			From:         "InfoSMS",
+			Text:         "Hello",
+			CallbackData: "data",
+			Destinations: []models.SMSDestination{{To: "41793026727"}, {To: "41793026728", MessageID: "custom-id"}},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	require.Len(t, resp.Messages, 2)
+	assert.NotEmpty(t, resp.BulkID)
+	assert.NotEmpty(t, resp.Messages[0].MessageID)
+	assert.Equal(t, "custom-id", resp.Messages[1].MessageID)
+	assert.Equal(t, StatusPending, resp.Messages[0].Status.GroupName)
+
+	msgs := server.Messages()
+	require.Len(t, msgs, 2)
+	assert.Equal(t, ChannelSMS, msgs[0].Channel)
+	assert.Equal(t, "Hello", msgs[0].Text)
+	assert.Equal(t, "sms/2/text/advanced", msgs[0].Path)
+	assert.Equal(t, resp.BulkID, msgs[1].BulkID)
+
+	require.NoError(t, server.SetStatus("custom-id", StatusUndeliverable))
+	reports, _, err := client.SMS.GetDeliveryReports(ctx, models.GetSMSDeliveryReportsParams{BulkID: resp.BulkID})
+	require.NoError(t, err)
+	require.Len(t, reports.Results, 2)
+	assert.Equal(t, StatusDelivered, reports.Results[0].Status.GroupName)
+	assert.Equal(t, "data", reports.Results[0].CallbackData)
+	assert.Equal(t, 1, reports.Results[0].SmsCount)
+	assert.Equal(t, StatusUndeliverable, reports.Results[1].Status.GroupName)
+	assert.True(t, reports.Results[1].Error.Permanent)
+
+	reports, _, err = client.SMS.GetDeliveryReports(ctx, models.GetSMSDeliveryReportsParams{BulkID: resp.BulkID})
+	require.NoError(t, err)
+	assert.Empty(t, reports.Results)
+}
+
+func TestSMSPendingMessagesHaveNoReports(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	server.SetDeliveryStatus(StatusPending)
+
+	resp, _, err := client.SMS.Send(ctx, models.SendSMSRequest{
+		Messages: []models.SMSMsg{{Text: "Hello", Destinations: []models.SMSDestination{{To: "41793026727"}}}},
+	})
+	require.NoError(t, err)
+
+	reports, _, err := client.SMS.GetDeliveryReports(ctx, models.GetSMSDeliveryReportsParams{})
+	require.NoError(t, err)
+	assert.Empty(t, reports.Results)
+
+	require.NoError(t, server.SetStatus(resp.Messages[0].MessageID, StatusDelivered))
+	reports, _, err = client.SMS.GetDeliveryReports(ctx, models.GetSMSDeliveryReportsParams{})
+	require.NoError(t, err)
+	require.Len(t, reports.Results, 1)
+	assert.Equal(t, resp.Messages[0].MessageID, reports.Results[0].MessageID)
+
+	assert.Error(t, server.SetStatus("unknown", StatusDelivered))
+}
+
+func TestGetSMSLogs(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	for _, to := range []string{"41793026727", "41793026728", "41793026729"} {
+		_, _, err := client.SMS.Send(ctx, models.SendSMSRequest{
+			Messages: []models.SMSMsg{{From: "InfoSMS", Text: "Hi " + to, Destinations: []models.SMSDestination{{To: to}}}},
+		})
+		require.NoError(t, err)
+	}
+
+	logs, _, err := client.SMS.GetLogs(ctx, models.GetSMSLogsParams{To: "41793026728"})
+	require.NoError(t, err)
+	require.Len(t, logs.Results, 1)
+	assert.Equal(t, "Hi 41793026728", logs.Results[0].Text)
+
+	logs, _, err = client.SMS.GetLogs(ctx, models.GetSMSLogsParams{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, logs.Results, 2)
+
+	logs, _, err = client.SMS.GetLogs(ctx, models.GetSMSLogsParams{
+		SentSince: time.Now().Add(time.Hour).Format(timeLayout),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, logs.Results)
+}
+
+func TestSMSScheduledBulks(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	sendAt := time.Now().Add(time.Hour).Format(timeLayout)
+
+	resp, _, err := client.SMS.Send(ctx, models.SendSMSRequest{
+		BulkID: "scheduled",
+		Messages: []models.SMSMsg{{
+			Text: "Later", SendAt: sendAt, Destinations: []models.SMSDestination{{To: "41793026727"}},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "scheduled", resp.BulkID)
+
+	scheduled, _, err := client.SMS.GetScheduledMessages(ctx, models.GetScheduledSMSParams{BulkID: "scheduled"})
+	require.NoError(t, err)
+	assert.Equal(t, sendAt, scheduled.SendAt)
+
+	newSendAt := time.Now().Add(2 * time.Hour).Format(timeLayout)
+	rescheduled, _, err := client.SMS.RescheduleMessages(ctx,
+		models.RescheduleSMSRequest{SendAt: newSendAt}, models.RescheduleSMSParams{BulkID: "scheduled"})
+	require.NoError(t, err)
+	assert.Equal(t, newSendAt, rescheduled.SendAt)
+
+	updated, _, err := client.SMS.UpdateScheduledMessagesStatus(ctx, models.UpdateScheduledSMSStatusRequest{
+		Status: "CANCELED",
+	}, models.UpdateScheduledSMSStatusParams{BulkID: "scheduled"})
+	require.NoError(t, err)
+	assert.Equal(t, "CANCELED", updated.Status)
+
+	status, _, err := client.SMS.GetScheduledMessagesStatus(ctx, models.GetScheduledSMSStatusParams{BulkID: "scheduled"})
+	require.NoError(t, err)
+	assert.Equal(t, "CANCELED", status.Status)
+
+	_, respDetails, err := client.SMS.GetScheduledMessages(ctx, models.GetScheduledSMSParams{BulkID: "unknown"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestGetInboundSMS(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	server.AddInbound(InboundMessage{Channel: ChannelSMS, From: "41793026727", To: "InfoSMS", Text: "KEY Hello"})
+	server.AddInbound(InboundMessage{Channel: ChannelSMS, From: "41793026728", To: "InfoSMS", Text: "Bye"})
+	server.AddInbound(InboundMessage{Channel: ChannelMMS, From: "41793026729", To: "InfoMMS", Text: "Picture"})
+
+	inbound, _, err := client.SMS.GetInboundMessages(ctx, models.GetInboundSMSParams{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, inbound.Results, 1)
+	assert.Equal(t, "KEY Hello", inbound.Results[0].Text)
+	assert.Equal(t, 1, inbound.PendingMessageCount)
+
+	inbound, _, err = client.SMS.GetInboundMessages(ctx, models.GetInboundSMSParams{})
+	require.NoError(t, err)
+	require.Len(t, inbound.Results, 1)
+	assert.Equal(t, "Bye", inbound.Results[0].Text)
+	assert.Equal(t, 0, inbound.PendingMessageCount)
+}
+
+func TestPreviewSMS(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	preview, _, err := client.SMS.Preview(context.Background(), models.PreviewSMSRequest{Text: "Ünïcödé"})
+	require.NoError(t, err)
+	require.Len(t, preview.Previews, 1)
+	assert.Equal(t, 1, preview.Previews[0].MessageCount)
+	assert.Equal(t, 63, preview.Previews[0].CharactersRemaining)
+}
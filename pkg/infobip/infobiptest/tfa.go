@@ -0,0 +1,439 @@
+package infobiptest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+const (
+	defaultPINAttempts = 10
+	defaultPINLength   = 4
+	pinPlaceholder     = "{{pin}}"
+)
+
+type tfaState struct {
+	apps      []models.TFAApplication
+	templates map[string][]models.TFAMessageTemplate
+	pins      []*pinState
+}
+
+type pinState struct {
+	id                string
+	appID             string
+	messageID         string
+	to                string
+	pin               string
+	attemptsRemaining int
+	verified          bool
+	sentAt            time.Time
+	verifiedAt        time.Time
+}
+
+func newTFAState() tfaState {
+	return tfaState{templates: map[string][]models.TFAMessageTemplate{}}
+}
+
+// PIN returns the PIN code sent with the given pinId, so that tests can verify phone numbers.
+func (s *Server) PIN(pinID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if pin := s.findPIN(pinID); pin != nil {
+		return pin.pin, true
+	}
+
+	return "", false
+}
+
+func (s *Server) registerTFARoutes() {
+	s.handle(http.MethodGet, "2fa/2/applications", s.getTFAApplications)
+	s.handle(http.MethodPost, "2fa/2/applications", s.createTFAApplication)
+	s.handle(http.MethodGet, "2fa/2/applications/{appId}", s.getTFAApplication)
+	s.handle(http.MethodPut, "2fa/2/applications/{appId}", s.updateTFAApplication)
+	s.handle(http.MethodGet, "2fa/2/applications/{appId}/messages", s.getTFAMessageTemplates)
+	s.handle(http.MethodPost, "2fa/2/applications/{appId}/messages", s.createTFAMessageTemplate)
+	s.handle(http.MethodGet, "2fa/2/applications/{appId}/messages/{messageId}", s.getTFAMessageTemplate)
+	s.handle(http.MethodPut, "2fa/2/applications/{appId}/messages/{messageId}", s.updateTFAMessageTemplate)
+	s.handle(http.MethodGet, "2fa/2/applications/{appId}/verifications", s.getTFAVerifications)
+	s.handle(http.MethodPost, "2fa/2/pin", s.sendPIN)
+	s.handle(http.MethodPost, "2fa/2/pin/voice", s.sendPIN)
+	s.handle(http.MethodPost, "2fa/2/pin/{pinId}/resend", s.resendPIN)
+	s.handle(http.MethodPost, "2fa/2/pin/{pinId}/resend/voice", s.resendPIN)
+	s.handle(http.MethodPost, "2fa/2/pin/{pinId}/verify", s.verifyPIN)
+}
+
+func (s *Server) getTFAApplications(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	s.mu.Lock()
+	apps := append([]models.TFAApplication{}, s.tfa.apps...)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, apps)
+}
+
+func (s *Server) createTFAApplication(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var app models.TFAApplication
+	if !readJSON(w, r, &app) {
+		return
+	}
+
+	s.mu.Lock()
+	app.ApplicationID = s.newID("application")
+	s.tfa.apps = append(s.tfa.apps, app)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) getTFAApplication(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	var app models.TFAApplication
+	found := s.findTFAApplication(params["appId"])
+	if found != nil {
+		app = *found
+	}
+	s.mu.Unlock()
+
+	if found == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Application not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) updateTFAApplication(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var update models.TFAApplication
+	if !readJSON(w, r, &update) {
+		return
+	}
+
+	s.mu.Lock()
+	app := s.findTFAApplication(params["appId"])
+	if app != nil {
+		update.ApplicationID = app.ApplicationID
+		*app = update
+	}
+	s.mu.Unlock()
+
+	if app == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Application not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, update)
+}
+
+func (s *Server) getTFAMessageTemplates(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	app := s.findTFAApplication(params["appId"])
+	templates := append([]models.TFAMessageTemplate{}, s.tfa.templates[params["appId"]]...)
+	s.mu.Unlock()
+
+	if app == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Application not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, templates)
+}
+
+func (s *Server) createTFAMessageTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var template models.TFAMessageTemplate
+	if !readJSON(w, r, &template) {
+		return
+	}
+
+	s.mu.Lock()
+	app := s.findTFAApplication(params["appId"])
+	if app != nil {
+		template.ApplicationID = app.ApplicationID
+		template.MessageID = s.newID("template")
+		s.tfa.templates[app.ApplicationID] = append(s.tfa.templates[app.ApplicationID], template)
+	}
+	s.mu.Unlock()
+
+	if app == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Application not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, template)
+}
+
+func (s *Server) getTFAMessageTemplate(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	var template models.TFAMessageTemplate
+	found := s.findTFAMessageTemplate(params["appId"], params["messageId"])
+	if found != nil {
+		template = *found
+	}
+	s.mu.Unlock()
+
+	if found == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Message template not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, template)
+}
+
+func (s *Server) updateTFAMessageTemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var update models.TFAMessageTemplate
+	if !readJSON(w, r, &update) {
+		return
+	}
+
+	s.mu.Lock()
+	template := s.findTFAMessageTemplate(params["appId"], params["messageId"])
+	if template != nil {
+		update.ApplicationID = template.ApplicationID
+		update.MessageID = template.MessageID
+		*template = update
+	}
+	s.mu.Unlock()
+
+	if template == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Message template not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, update)
+}
+
+func (s *Server) sendPIN(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req models.SendPINRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	voice := strings.HasSuffix(requestPath(r), "/voice")
+
+	s.mu.Lock()
+	app := s.findTFAApplication(req.ApplicationID)
+	template := s.findTFAMessageTemplate(req.ApplicationID, req.MessageID)
+	if app == nil || template == nil {
+		s.mu.Unlock()
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Application or message template not found")
+		return
+	}
+	pin := &pinState{
+		id:                s.newID("pin"),
+		appID:             app.ApplicationID,
+		messageID:         template.MessageID,
+		to:                req.To,
+		pin:               generatePIN(template.PINType, template.PINLength),
+		attemptsRemaining: pinAttempts(app),
+		sentAt:            time.Now(),
+	}
+	s.tfa.pins = append(s.tfa.pins, pin)
+	if !voice {
+		s.storePINMessage(r, pin, template, req.From, req.Placeholders)
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, pinResponse(pin, voice, r.URL.Query().Get("ncNeeded") == "true"))
+}
+
+func (s *Server) resendPIN(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req models.ResendPINRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	voice := strings.HasSuffix(requestPath(r), "/voice")
+
+	s.mu.Lock()
+	pin := s.findPIN(params["pinId"])
+	if pin == nil {
+		s.mu.Unlock()
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "PIN not found")
+		return
+	}
+	pin.sentAt = time.Now()
+	if template := s.findTFAMessageTemplate(pin.appID, pin.messageID); template != nil && !voice {
+		s.storePINMessage(r, pin, template, "", req.Placeholders)
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, pinResponse(pin, voice, false))
+}
+
+func (s *Server) verifyPIN(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req models.VerifyPhoneNumberRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	pin := s.findPIN(params["pinId"])
+	if pin == nil {
+		s.mu.Unlock()
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "PIN not found")
+		return
+	}
+	if !pin.verified && pin.attemptsRemaining > 0 {
+		if req.PIN == pin.pin {
+			pin.verified = true
+			pin.verifiedAt = time.Now()
+		} else {
+			pin.attemptsRemaining--
+		}
+	}
+	resp := models.VerifyPhoneNumberResponse{
+		PINID:             pin.id,
+		MSISDN:            pin.to,
+		Verified:          pin.verified,
+		AttemptsRemaining: pin.attemptsRemaining,
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+type verification struct {
+	Msisdn     string `json:"msisdn"`
+	Verified   bool   `json:"verified"`
+	VerifiedAt int64  `json:"verifiedAt"`
+	SentAt     int64  `json:"sentAt"`
+}
+
+func (s *Server) getTFAVerifications(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	verifications := []verification{}
+	for _, pin := range s.tfa.pins {
+		if pin.appID != params["appId"] || pin.to != query.Get("msisdn") {
+			continue
+		}
+		if query.Get("verified") == "true" && !pin.verified {
+			continue
+		}
+		v := verification{Msisdn: pin.to, Verified: pin.verified, SentAt: millis(pin.sentAt)}
+		if pin.verified {
+			v.VerifiedAt = millis(pin.verifiedAt)
+		}
+		verifications = append(verifications, v)
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, struct {
+		Verifications []verification `json:"verifications"`
+	}{Verifications: verifications})
+}
+
+// storePINMessage stores the SMS sending a PIN. It has to be called with the lock held.
+func (s *Server) storePINMessage(
+	r *http.Request,
+	pin *pinState,
+	template *models.TFAMessageTemplate,
+	from string,
+	placeholders map[string]string,
+) {
+	placeholder := template.PINPlaceholder
+	if placeholder == "" {
+		placeholder = pinPlaceholder
+	}
+	text := strings.ReplaceAll(template.MessageText, placeholder, pin.pin)
+	for name, value := range placeholders {
+		text = strings.ReplaceAll(text, "{{"+name+"}}", value)
+	}
+	if from == "" {
+		from = template.SenderID
+	}
+	payload, _ := json.Marshal(map[string]string{"pinId": pin.id, "to": pin.to, "text": text})
+
+	s.store(Message{
+		Channel: ChannelSMS,
+		From:    from,
+		To:      pin.to,
+		Text:    text,
+		Payload: payload,
+		Path:    requestPath(r),
+	})
+}
+
+// findTFAApplication returns an application by ID. It has to be called with the lock held.
+func (s *Server) findTFAApplication(appID string) *models.TFAApplication {
+	for i := range s.tfa.apps {
+		if s.tfa.apps[i].ApplicationID == appID {
+			return &s.tfa.apps[i]
+		}
+	}
+
+	return nil
+}
+
+// findTFAMessageTemplate returns a message template by ID. It has to be called with the lock held.
+func (s *Server) findTFAMessageTemplate(appID string, messageID string) *models.TFAMessageTemplate {
+	templates := s.tfa.templates[appID]
+	for i := range templates {
+		if templates[i].MessageID == messageID {
+			return &templates[i]
+		}
+	}
+
+	return nil
+}
+
+// findPIN returns a PIN by ID. It has to be called with the lock held.
+func (s *Server) findPIN(pinID string) *pinState {
+	for _, pin := range s.tfa.pins {
+		if pin.id == pinID {
+			return pin
+		}
+	}
+
+	return nil
+}
+
+func pinResponse(pin *pinState, voice bool, ncNeeded bool) models.SendPINResponse {
+	resp := models.SendPINResponse{PINID: pin.id, To: pin.to}
+	if voice {
+		resp.CallStatus = "PENDING_ACCEPTED"
+	} else {
+		resp.SMSStatus = "MESSAGE_SENT"
+	}
+	if ncNeeded {
+		resp.NCStatus = "NC_DESTINATION_REACHABLE"
+	}
+
+	return resp
+}
+
+func pinAttempts(app *models.TFAApplication) int {
+	if app.Configuration == nil || app.Configuration.PINAttempts < 1 {
+		return defaultPINAttempts
+	}
+
+	return app.Configuration.PINAttempts
+}
+
+func generatePIN(pinType models.PINType, length int) string {
+	alphabet := "0123456789"
+	switch pinType {
+	case models.ALPHA:
+		alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	case models.HEX:
+		alphabet = "0123456789ABCDEF"
+	case models.ALPHANUMERIC:
+		alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	case models.NUMERIC:
+	}
+	if length < 1 {
+		length = defaultPINLength
+	}
+
+	pin := make([]byte, length)
+	for i := range pin {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
+		if err != nil {
+			panic(err)
+		}
+		pin[i] = alphabet[n.Int64()]
+	}
+
+	return string(pin)
+}
+
+func millis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
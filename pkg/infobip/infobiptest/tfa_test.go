@@ -0,0 +1,132 @@
+package infobiptest
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTFAApplicationsAndTemplates(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	app, respDetails, err := client.SMS.CreateTFAApplication(ctx, models.CreateTFAApplicationRequest{
+		Name: "2fa app", Enabled: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.NotEmpty(t, app.ApplicationID)
+
+	updated, _, err := client.SMS.UpdateTFAApplication(ctx, app.ApplicationID, models.UpdateTFAApplicationRequest{
+		Name: "renamed", Enabled: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", updated.Name)
+
+	apps, _, err := client.SMS.GetTFAApplications(ctx)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "renamed", apps[0].Name)
+
+	template, _, err := client.SMS.CreateTFAMessageTemplate(ctx, app.ApplicationID, models.CreateTFAMessageTemplateRequest{
+		MessageText: "Your PIN is {{pin}}", PINLength: 4, PINType: models.NUMERIC,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, template.MessageID)
+	assert.Equal(t, app.ApplicationID, template.ApplicationID)
+
+	got, _, err := client.SMS.GetTFAMessageTemplate(ctx, app.ApplicationID, template.MessageID)
+	require.NoError(t, err)
+	assert.Equal(t, "Your PIN is {{pin}}", got.MessageText)
+
+	_, respDetails, err = client.SMS.GetTFAMessageTemplate(ctx, app.ApplicationID, "unknown")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestSendAndVerifyPIN(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	app, _, err := client.SMS.CreateTFAApplication(ctx, models.CreateTFAApplicationRequest{
+		Name:          "2fa app",
+		Enabled:       true,
+		Configuration: &models.TFAApplicationConfiguration{PINAttempts: 2},
+	})
+	require.NoError(t, err)
+	template, _, err := client.SMS.CreateTFAMessageTemplate(ctx, app.ApplicationID, models.CreateTFAMessageTemplateRequest{
+		MessageText: "Your PIN is {{pin}}", PINLength: 6, PINType: models.NUMERIC,
+	})
+	require.NoError(t, err)
+
+	sent, _, err := client.SMS.SendPINOverSMS(ctx, models.SendPINOverSMSParams{}, models.SendPINOverSMSRequest{
+		ApplicationID: app.ApplicationID, MessageID: template.MessageID, From: "InfoSMS", To: "41793026727",
+	})
+	require.NoError(t, err)
+	pin, ok := server.PIN(sent.PINID)
+	require.True(t, ok)
+	assert.Regexp(t, regexp.MustCompile(`^\d{6}$`), pin)
+
+	msgs := server.Messages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "Your PIN is "+pin, msgs[0].Text)
+	assert.Equal(t, "41793026727", msgs[0].To)
+
+	verified, _, err := client.SMS.VerifyPhoneNumber(ctx, sent.PINID, models.VerifyPhoneNumberRequest{PIN: "wrong"})
+	require.NoError(t, err)
+	assert.False(t, verified.Verified)
+	assert.Equal(t, 1, verified.AttemptsRemaining)
+
+	verified, _, err = client.SMS.VerifyPhoneNumber(ctx, sent.PINID, models.VerifyPhoneNumberRequest{PIN: pin})
+	require.NoError(t, err)
+	assert.True(t, verified.Verified)
+
+	status, _, err := client.SMS.GetTFAVerificationStatus(ctx, app.ApplicationID,
+		models.GetTFAVerificationStatusParams{MSISDN: "41793026727", Verified: true})
+	require.NoError(t, err)
+	require.Len(t, status.Verifications, 1)
+	assert.True(t, status.Verifications[0].Verified)
+}
+
+func TestPINAttemptsExhausted(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	app, _, err := client.SMS.CreateTFAApplication(ctx, models.CreateTFAApplicationRequest{
+		Name:          "2fa app",
+		Configuration: &models.TFAApplicationConfiguration{PINAttempts: 1},
+	})
+	require.NoError(t, err)
+	template, _, err := client.SMS.CreateTFAMessageTemplate(ctx, app.ApplicationID, models.CreateTFAMessageTemplateRequest{
+		MessageText: "PIN: {{pin}}", PINLength: 4, PINType: models.HEX,
+	})
+	require.NoError(t, err)
+	sent, _, err := client.SMS.SendPINOverVoice(ctx, models.SendPINOverVoiceRequest{
+		ApplicationID: app.ApplicationID, MessageID: template.MessageID, To: "41793026727",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, server.Messages())
+	pin, ok := server.PIN(sent.PINID)
+	require.True(t, ok)
+
+	_, _, err = client.SMS.VerifyPhoneNumber(ctx, sent.PINID, models.VerifyPhoneNumberRequest{PIN: "wrong"})
+	require.NoError(t, err)
+	verified, _, err := client.SMS.VerifyPhoneNumber(ctx, sent.PINID, models.VerifyPhoneNumberRequest{PIN: pin})
+	require.NoError(t, err)
+	assert.False(t, verified.Verified)
+	assert.Equal(t, 0, verified.AttemptsRemaining)
+
+	_, ok = server.PIN("unknown")
+	assert.False(t, ok)
+}
@@ -0,0 +1,135 @@
+package infobiptest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+const (
+	tokenBytes             = 32
+	defaultTokenTimeToLive = 43200
+)
+
+func (s *Server) registerWebRTCRoutes() {
+	s.handle(http.MethodGet, "webrtc/1/applications", s.getWebRTCApplications)
+	s.handle(http.MethodPost, "webrtc/1/applications", s.saveWebRTCApplication)
+	s.handle(http.MethodGet, "webrtc/1/applications/{appId}", s.getWebRTCApplication)
+	s.handle(http.MethodPut, "webrtc/1/applications/{appId}", s.updateWebRTCApplication)
+	s.handle(http.MethodDelete, "webrtc/1/applications/{appId}", s.deleteWebRTCApplication)
+	s.handle(http.MethodPost, "webrtc/1/token", generateWebRTCToken)
+}
+
+func (s *Server) getWebRTCApplications(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	s.mu.Lock()
+	apps := append(models.GetWebRTCApplicationsResponse{}, s.webRTCApps...)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, apps)
+}
+
+func (s *Server) saveWebRTCApplication(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var app models.WebRTCApplication
+	if !readJSON(w, r, &app) {
+		return
+	}
+
+	s.mu.Lock()
+	app.ID = s.newID("app")
+	s.webRTCApps = append(s.webRTCApps, app)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, app)
+}
+
+func (s *Server) getWebRTCApplication(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	var app models.WebRTCApplication
+	found := s.findWebRTCApplication(params["appId"])
+	if found != nil {
+		app = *found
+	}
+	s.mu.Unlock()
+
+	if found == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Application not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) updateWebRTCApplication(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var app models.WebRTCApplication
+	if !readJSON(w, r, &app) {
+		return
+	}
+	app.ID = params["appId"]
+
+	s.mu.Lock()
+	found := s.findWebRTCApplication(app.ID)
+	if found != nil {
+		*found = app
+	}
+	s.mu.Unlock()
+
+	if found == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Application not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) deleteWebRTCApplication(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	var kept []models.WebRTCApplication
+	for _, app := range s.webRTCApps {
+		if app.ID != params["appId"] {
+			kept = append(kept, app)
+		}
+	}
+	deleted := len(kept) < len(s.webRTCApps)
+	s.webRTCApps = kept
+	s.mu.Unlock()
+
+	if !deleted {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Application not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// generateWebRTCToken returns a random token, valid for the requested time to live, 12 hours by default.
+func generateWebRTCToken(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req models.GenerateWebRTCTokenRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	token := make([]byte, tokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", err.Error())
+		return
+	}
+	ttl := req.TimeToLive
+	if ttl <= 0 {
+		ttl = defaultTokenTimeToLive
+	}
+
+	writeJSON(w, http.StatusOK, models.GenerateWebRTCTokenResponse{
+		Token:          hex.EncodeToString(token),
+		ExpirationTime: formatTime(time.Now().Add(time.Duration(ttl) * time.Second)),
+	})
+}
+
+// findWebRTCApplication returns an application by ID. It has to be called with the lock held.
+func (s *Server) findWebRTCApplication(appID string) *models.WebRTCApplication {
+	for i := range s.webRTCApps {
+		if s.webRTCApps[i].ID == appID {
+			return &s.webRTCApps[i]
+		}
+	}
+
+	return nil
+}
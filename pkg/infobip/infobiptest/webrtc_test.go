@@ -0,0 +1,55 @@
+package infobiptest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebRTCApplications(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	app, respDetails, err := client.WebRTC.SaveApplication(ctx, models.WebRTCApplication{Name: "app", AppToApp: true})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, respDetails.HTTPResponse.StatusCode)
+	assert.NotEmpty(t, app.ID)
+
+	updated, _, err := client.WebRTC.UpdateApplication(ctx, app.ID, models.WebRTCApplication{Name: "renamed"})
+	require.NoError(t, err)
+	assert.Equal(t, app.ID, updated.ID)
+	assert.Equal(t, "renamed", updated.Name)
+
+	apps, _, err := client.WebRTC.GetApplications(ctx)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "renamed", apps[0].Name)
+
+	respDetails, err = client.WebRTC.DeleteApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+
+	_, respDetails, err = client.WebRTC.GetApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestGenerateWebRTCToken(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	token, _, err := client.WebRTC.GenerateToken(context.Background(), models.GenerateWebRTCTokenRequest{
+		Identity: "alice", TimeToLive: 60,
+	})
+	require.NoError(t, err)
+	assert.Len(t, token.Token, 2*tokenBytes)
+	_, ok := parseTime(token.ExpirationTime)
+	assert.True(t, ok)
+}
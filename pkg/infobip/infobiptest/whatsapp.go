@@ -0,0 +1,728 @@
+package infobiptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+const (
+	waSenderPendingVerification = "PENDING_VERIFICATION"
+	waSenderConnected           = "CONNECTED"
+	waQualityRatingGreen        = "GREEN"
+	waMessagingLimit            = "TIER_1K"
+	waPaymentPending            = "PENDING"
+	waVerificationCodeLength    = 6
+	waIdentityHashLength        = 16
+)
+
+type waState struct {
+	senders    []*waSender
+	media      map[string]*waMedia
+	identities map[string]*models.WAIdentity
+	payments   map[string]*models.WAPaymentStatus
+}
+
+type waSender struct {
+	models.WASender
+	verificationCode string
+	updatedAt        time.Time
+	profile          models.WABusinessProfile
+}
+
+type waMedia struct {
+	sender      string
+	url         string
+	contentType string
+	content     []byte
+}
+
+func newWAState() waState {
+	return waState{
+		media:      map[string]*waMedia{},
+		identities: map[string]*models.WAIdentity{},
+		payments:   map[string]*models.WAPaymentStatus{},
+	}
+}
+
+// waMsg holds the fields of WhatsApp messages which are stored, whatever their type.
+type waMsg struct {
+	models.MsgCommon
+	Content struct {
+		Text         string `json:"text"`
+		Caption      string `json:"caption"`
+		TemplateName string `json:"templateName"`
+		Body         struct {
+			Text string `json:"text"`
+		} `json:"body"`
+		Action struct {
+			ReferenceID string                        `json:"referenceId"`
+			Currency    string                        `json:"currency"`
+			TotalAmount models.InteractiveOrderAmount `json:"totalAmount"`
+		} `json:"action"`
+	} `json:"content"`
+}
+
+func (m waMsg) text() string {
+	for _, text := range []string{m.Content.Text, m.Content.Body.Text, m.Content.Caption} {
+		if text != "" {
+			return text
+		}
+	}
+
+	return m.Content.TemplateName
+}
+
+// SetTemplateStatus changes the status of a WhatsApp template, e.g. to APPROVED or REJECTED. Templates are
+// created with the PENDING status.
+func (s *Server) SetTemplateStatus(sender string, templateID string, status string, rejectionReason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template := s.findTemplate(sender, templateID)
+	if template == nil {
+		return fmt.Errorf("infobiptest: unknown template %q of sender %q", templateID, sender)
+	}
+	template.Status = status
+	template.RejectionReason = rejectionReason
+
+	return nil
+}
+
+// AddSender adds a WhatsApp sender, as if it had been registered and verified. Its status, quality rating and
+// messaging limit default to CONNECTED, GREEN and TIER_1K.
+func (s *Server) AddSender(sender models.WASender) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sender.Status == "" {
+		sender.Status = waSenderConnected
+	}
+	if sender.QualityRating == "" {
+		sender.QualityRating = waQualityRatingGreen
+	}
+	if sender.MessagingLimit == "" {
+		sender.MessagingLimit = waMessagingLimit
+	}
+	s.whatsApp.senders = append(s.whatsApp.senders, &waSender{WASender: sender, updatedAt: time.Now()})
+}
+
+// VerificationCode returns the code sent to a WhatsApp sender being registered, so that tests can verify it.
+func (s *Server) VerificationCode(sender string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if found := s.findSender(sender); found != nil && found.verificationCode != "" {
+		return found.verificationCode, true
+	}
+
+	return "", false
+}
+
+// AddMedia stores a media file of a WhatsApp sender, such as one received in an inbound message, and returns its
+// URL.
+func (s *Server) AddMedia(sender string, contentType string, content []byte) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.storeMedia(sender, contentType, content).url
+}
+
+// ChangeIdentity changes the identity of a WhatsApp user, as when they re-install WhatsApp, so that it has to be
+// confirmed again.
+func (s *Server) ChangeIdentity(sender string, userNumber string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.whatsApp.identities[sender+"/"+userNumber] = newIdentity(false)
+}
+
+// SetPaymentStatus changes the status of the payment of an order sent by a WhatsApp sender, e.g. to CAPTURED or
+// FAILED. Payments are created with the PENDING status when order details messages are sent.
+func (s *Server) SetPaymentStatus(sender string, referenceID string, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	payment, ok := s.whatsApp.payments[sender+"/"+referenceID]
+	if !ok {
+		return fmt.Errorf("infobiptest: unknown payment %q of sender %q", referenceID, sender)
+	}
+	payment.Status = status
+	payment.UpdatedAt = formatTime(time.Now())
+	if payment.TransactionID == "" {
+		payment.TransactionID = s.newID("transaction")
+	}
+
+	return nil
+}
+
+func (s *Server) registerWhatsAppRoutes() {
+	s.handle(http.MethodPost, "whatsapp/1/message/template", s.sendWATemplateMsgs)
+	s.handle(http.MethodPost, "whatsapp/1/message/{type}", s.sendWAMsg)
+	s.handle(http.MethodPost, "whatsapp/1/message/interactive/{type}", s.sendWAMsg)
+	s.handle(http.MethodPost, "whatsapp/1/senders/{sender}/message/{messageId}/read", s.markWAMsgAsRead)
+	s.handle(http.MethodGet, "whatsapp/2/senders/{sender}/templates", s.getWATemplates)
+	s.handle(http.MethodPost, "whatsapp/2/senders/{sender}/templates", s.createWATemplate)
+	s.handle(http.MethodGet, "whatsapp/2/senders/{sender}/templates/{template}", s.getWATemplate)
+	s.handle(http.MethodPatch, "whatsapp/2/senders/{sender}/templates/{template}", s.editWATemplate)
+	s.handle(http.MethodDelete, "whatsapp/2/senders/{sender}/templates/{template}", s.deleteWATemplate)
+	s.handle(http.MethodPost, "whatsapp/1/senders/{sender}/media", s.uploadWAMedia)
+	s.handle(http.MethodDelete, "whatsapp/1/senders/{sender}/media", s.deleteWAMedia)
+	s.handle(http.MethodGet, "whatsapp/1/senders/{sender}/media/{mediaId}", s.downloadWAMedia)
+	s.handle(http.MethodHead, "whatsapp/1/senders/{sender}/media/{mediaId}", s.downloadWAMedia)
+	s.handle(http.MethodGet, "whatsapp/1/senders/{sender}/payments/{referenceId}", s.getWAPayment)
+	s.handle(http.MethodGet, "whatsapp/1/{sender}/contacts/{userNumber}/identity", s.getWAIdentity)
+	s.handle(http.MethodPut, "whatsapp/1/{sender}/contacts/{userNumber}/identity", s.confirmWAIdentity)
+	s.handle(http.MethodGet, "whatsapp/2/senders", s.listWASenders)
+	s.handle(http.MethodPost, "whatsapp/2/senders", s.registerWASender)
+	s.handle(http.MethodPost, "whatsapp/2/senders/{sender}/verification", s.verifyWASender)
+	s.handle(http.MethodGet, "whatsapp/2/senders/{sender}/quality", s.getWASenderQuality)
+	s.handle(http.MethodGet, "whatsapp/2/senders/{sender}/business-profile", s.getWABusinessProfile)
+	s.handle(http.MethodPatch, "whatsapp/2/senders/{sender}/business-profile", s.updateWABusinessProfile)
+	s.handle(http.MethodPost, "whatsapp/2/senders/{sender}/business-profile/photo", s.uploadWABusinessProfilePhoto)
+}
+
+func (s *Server) sendWATemplateMsgs(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req struct {
+		BulkID   string            `json:"bulkId"`
+		Messages []json.RawMessage `json:"messages"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	msgs := make([]waMsg, 0, len(req.Messages))
+	for _, raw := range req.Messages {
+		var msg waMsg
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+			return
+		}
+		msgs = append(msgs, msg)
+	}
+
+	s.mu.Lock()
+	resp := models.BulkWAMsgResponse{BulkID: req.BulkID, Messages: []models.SendWAMsgResponse{}}
+	if resp.BulkID == "" {
+		resp.BulkID = s.newID("bulk")
+	}
+	for i, msg := range msgs {
+		stored := s.storeWAMsg(r, msg, resp.BulkID, req.Messages[i])
+		resp.Messages = append(resp.Messages, sentWAMsg(stored))
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) sendWAMsg(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var payload json.RawMessage
+	if !readJSON(w, r, &payload) {
+		return
+	}
+	var msg waMsg
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	stored := s.storeWAMsg(r, msg, "", payload)
+	if params["type"] == "order-details" {
+		s.storeWAPayment(msg)
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, sentWAMsg(stored))
+}
+
+func (s *Server) markWAMsgAsRead(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getWATemplates(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	templates := append([]models.CreateWATemplateResponse{}, s.templates[params["sender"]]...)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, models.GetWATemplatesResponse{Templates: templates})
+}
+
+func (s *Server) createWATemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req models.TemplateCreate
+	if !readJSON(w, r, &req) {
+		return
+	}
+	sender := params["sender"]
+
+	s.mu.Lock()
+	template := models.CreateWATemplateResponse{
+		ID:        s.newID("template"),
+		Name:      req.Name,
+		Language:  req.Language,
+		Status:    models.TemplateStatusPending,
+		Category:  req.Category,
+		Structure: req.Structure,
+	}
+	s.templates[sender] = append(s.templates[sender], template)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, template)
+}
+
+func (s *Server) getWATemplate(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	var template models.CreateWATemplateResponse
+	found := s.findTemplate(params["sender"], params["template"])
+	if found != nil {
+		template = *found
+	}
+	s.mu.Unlock()
+
+	if found == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Template not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, template)
+}
+
+// editWATemplate updates a template, which has to be reviewed again.
+func (s *Server) editWATemplate(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req models.TemplateEdit
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	var template models.CreateWATemplateResponse
+	found := s.findTemplate(params["sender"], params["template"])
+	if found != nil {
+		if req.Category != "" {
+			found.Category = req.Category
+		}
+		found.Structure = req.Structure
+		found.Status = models.TemplateStatusPending
+		found.RejectionReason = ""
+		template = *found
+	}
+	s.mu.Unlock()
+
+	if found == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Template not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, template)
+}
+
+// deleteWATemplate deletes the templates with the given name, in all their languages.
+func (s *Server) deleteWATemplate(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	sender := params["sender"]
+
+	s.mu.Lock()
+	var kept []models.CreateWATemplateResponse
+	for _, template := range s.templates[sender] {
+		if template.Name != params["template"] {
+			kept = append(kept, template)
+		}
+	}
+	deleted := len(kept) < len(s.templates[sender])
+	s.templates[sender] = kept
+	s.mu.Unlock()
+
+	if !deleted {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Template not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) uploadWAMedia(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	content, contentType, ok := readFormFile(w, r, "mediaFile")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	media := s.storeMedia(params["sender"], contentType, content)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, models.UploadWAMediaResponse{URL: media.url})
+}
+
+// downloadWAMedia serves the content of a media file, or only its headers for HEAD requests.
+func (s *Server) downloadWAMedia(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	media, ok := s.whatsApp.media[params["mediaId"]]
+	s.mu.Unlock()
+
+	if !ok || media.sender != params["sender"] {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Media not found")
+		return
+	}
+	w.Header().Set("Content-Type", media.contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(media.content)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		_, _ = w.Write(media.content)
+	}
+}
+
+func (s *Server) deleteWAMedia(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req models.DeleteWAMediaRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	deleted := false
+	for id, media := range s.whatsApp.media {
+		if media.sender == params["sender"] && media.url == req.URL {
+			delete(s.whatsApp.media, id)
+			deleted = true
+		}
+	}
+	s.mu.Unlock()
+
+	if !deleted {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Media not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getWAPayment(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	var payment models.WAPaymentStatus
+	found, ok := s.whatsApp.payments[params["sender"]+"/"+params["referenceId"]]
+	if ok {
+		payment = *found
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Payment not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, payment)
+}
+
+// getWAIdentity serves the identity of a user, which is acknowledged until it is changed with ChangeIdentity.
+func (s *Server) getWAIdentity(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	key := params["sender"] + "/" + params["userNumber"]
+
+	s.mu.Lock()
+	identity, ok := s.whatsApp.identities[key]
+	if !ok {
+		identity = newIdentity(true)
+		s.whatsApp.identities[key] = identity
+	}
+	resp := *identity
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) confirmWAIdentity(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req models.ConfirmWAIdentityRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	identity, ok := s.whatsApp.identities[params["sender"]+"/"+params["userNumber"]]
+	confirmed := ok && identity.Hash == req.Hash
+	if confirmed {
+		identity.Acknowledged = true
+	}
+	s.mu.Unlock()
+
+	if !confirmed {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Identity hash does not match")
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listWASenders(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	s.mu.Lock()
+	resp := models.GetWASendersResponse{Senders: []models.WASender{}}
+	for _, sender := range s.whatsApp.senders {
+		resp.Senders = append(resp.Senders, sender.WASender)
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// registerWASender adds a sender waiting for the verification code returned by VerificationCode.
+func (s *Server) registerWASender(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req models.RegisterWASenderRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	exists := s.findSender(req.PhoneNumber) != nil
+	sender := &waSender{
+		WASender: models.WASender{
+			Sender:      req.PhoneNumber,
+			DisplayName: req.DisplayName,
+			Status:      waSenderPendingVerification,
+		},
+		verificationCode: generatePIN(models.NUMERIC, waVerificationCodeLength),
+		updatedAt:        time.Now(),
+	}
+	if !exists {
+		s.whatsApp.senders = append(s.whatsApp.senders, sender)
+	}
+	s.mu.Unlock()
+
+	if exists {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Sender already registered")
+		return
+	}
+	writeJSON(w, http.StatusCreated, sender.WASender)
+}
+
+func (s *Server) verifyWASender(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req models.VerifyWASenderRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	var resp models.WASender
+	sender := s.findSender(params["sender"])
+	verified := sender != nil && sender.Status == waSenderPendingVerification && sender.verificationCode == req.Code
+	if verified {
+		sender.Status = waSenderConnected
+		sender.QualityRating = waQualityRatingGreen
+		sender.MessagingLimit = waMessagingLimit
+		sender.verificationCode = ""
+		sender.updatedAt = time.Now()
+		resp = sender.WASender
+	}
+	s.mu.Unlock()
+
+	switch {
+	case sender == nil:
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Sender not found")
+	case !verified:
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Invalid verification code")
+	default:
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+func (s *Server) getWASenderQuality(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	var quality models.WASenderQuality
+	sender := s.findSender(params["sender"])
+	if sender != nil {
+		quality = models.WASenderQuality{
+			Sender:         sender.Sender,
+			QualityRating:  sender.QualityRating,
+			Status:         sender.Status,
+			MessagingLimit: sender.MessagingLimit,
+			LastUpdated:    formatTime(sender.updatedAt),
+		}
+	}
+	s.mu.Unlock()
+
+	if sender == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Sender not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, quality)
+}
+
+func (s *Server) getWABusinessProfile(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	s.mu.Lock()
+	var profile models.WABusinessProfile
+	sender := s.findSender(params["sender"])
+	if sender != nil {
+		profile = sender.profile
+	}
+	s.mu.Unlock()
+
+	if sender == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Sender not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, profile)
+}
+
+// updateWABusinessProfile updates the fields of a business profile which are set in the request.
+func (s *Server) updateWABusinessProfile(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var req models.WABusinessProfile
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	sender := s.findSender(params["sender"])
+	if sender != nil {
+		profile := &sender.profile
+		for _, field := range []struct{ target, value *string }{
+			{&profile.About, &req.About},
+			{&profile.Address, &req.Address},
+			{&profile.Description, &req.Description},
+			{&profile.Email, &req.Email},
+			{&profile.Vertical, &req.Vertical},
+			{&profile.PhotoURL, &req.PhotoURL},
+		} {
+			if *field.value != "" {
+				*field.target = *field.value
+			}
+		}
+		if req.Websites != nil {
+			profile.Websites = req.Websites
+		}
+	}
+	s.mu.Unlock()
+
+	if sender == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Sender not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// uploadWABusinessProfilePhoto stores a profile photo as a media file, whose URL becomes the photo URL of the
+// business profile.
+func (s *Server) uploadWABusinessProfilePhoto(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	content, contentType, ok := readFormFile(w, r, "photo")
+	if !ok {
+		return
+	}
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Unsupported photo type: "+contentType)
+		return
+	}
+
+	s.mu.Lock()
+	sender := s.findSender(params["sender"])
+	if sender != nil {
+		sender.profile.PhotoURL = s.storeMedia(sender.Sender, contentType, content).url
+	}
+	s.mu.Unlock()
+
+	if sender == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Sender not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// storeWAMsg stores a WhatsApp message. It has to be called with the lock held.
+func (s *Server) storeWAMsg(r *http.Request, msg waMsg, bulkID string, payload json.RawMessage) *Message {
+	return s.store(Message{
+		Channel:      ChannelWhatsApp,
+		BulkID:       bulkID,
+		MessageID:    msg.MessageID,
+		From:         msg.From,
+		To:           msg.To,
+		Text:         msg.text(),
+		CallbackData: msg.CallbackData,
+		Payload:      payload,
+		Path:         requestPath(r),
+	})
+}
+
+// storeWAPayment creates the pending payment of an order details message. It has to be called with the lock held.
+func (s *Server) storeWAPayment(msg waMsg) {
+	action := msg.Content.Action
+	now := formatTime(time.Now())
+	s.whatsApp.payments[msg.From+"/"+action.ReferenceID] = &models.WAPaymentStatus{
+		ReferenceID: action.ReferenceID,
+		Status:      waPaymentPending,
+		Currency:    action.Currency,
+		TotalAmount: action.TotalAmount,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// storeMedia stores a media file, assigning it an ID and a URL. It has to be called with the lock held.
+func (s *Server) storeMedia(sender string, contentType string, content []byte) *waMedia {
+	id := s.newID("media")
+	media := &waMedia{
+		sender:      sender,
+		url:         fmt.Sprintf("%s/whatsapp/1/senders/%s/media/%s", s.URL, sender, id),
+		contentType: contentType,
+		content:     content,
+	}
+	s.whatsApp.media[id] = media
+
+	return media
+}
+
+// findSender returns a sender by phone number. It has to be called with the lock held.
+func (s *Server) findSender(sender string) *waSender {
+	for _, found := range s.whatsApp.senders {
+		if found.Sender == sender {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// findTemplate returns a template by ID. It has to be called with the lock held.
+func (s *Server) findTemplate(sender string, templateID string) *models.CreateWATemplateResponse {
+	templates := s.templates[sender]
+	for i := range templates {
+		if templates[i].ID == templateID {
+			return &templates[i]
+		}
+	}
+
+	return nil
+}
+
+func sentWAMsg(msg *Message) models.SendWAMsgResponse {
+	pending := statusOf(StatusPending)
+	return models.SendWAMsgResponse{
+		To:           msg.To,
+		MessageCount: 1,
+		MessageID:    msg.MessageID,
+		Status: models.Status{
+			GroupID:     int32(pending.GroupID),
+			GroupName:   pending.GroupName,
+			ID:          int32(pending.ID),
+			Name:        pending.Name,
+			Description: pending.Description,
+		},
+	}
+}
+
+func newIdentity(acknowledged bool) *models.WAIdentity {
+	return &models.WAIdentity{
+		Acknowledged: acknowledged,
+		Hash:         generatePIN(models.HEX, waIdentityHashLength),
+		CreatedAt:    formatTime(time.Now()),
+	}
+}
+
+// readFormFile reads a file part of a multipart request, responding with 400 when it is missing.
+func readFormFile(w http.ResponseWriter, r *http.Request, fieldName string) ([]byte, string, bool) {
+	file, header, err := r.FormFile(fieldName)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+		return nil, "", false
+	}
+	defer file.Close()
+
+	content, err := ioutil.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "Bad request: "+err.Error())
+		return nil, "", false
+	}
+
+	return content, header.Header.Get("Content-Type"), true
+}
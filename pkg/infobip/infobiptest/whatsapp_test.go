@@ -0,0 +1,306 @@
+package infobiptest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendWAMessages(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	resp, respDetails, err := client.WhatsApp.SendText(ctx, models.WATextMsg{
+		MsgCommon: models.MsgCommon{From: "441134960000", To: "441134960001", CallbackData: "data"},
+		Content:   models.TextContent{Text: "Hello"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.NotEmpty(t, resp.MessageID)
+	assert.Equal(t, "441134960001", resp.To)
+	assert.Equal(t, StatusPending, resp.Status.GroupName)
+
+	bulk, _, err := client.WhatsApp.SendTemplate(ctx, models.WATemplateMsgs{
+		BulkID: "bulk",
+		Messages: []models.TemplateMsg{{
+			MsgCommon: models.MsgCommon{From: "441134960000", To: "441134960002"},
+			Content: models.TemplateMsgContent{
+				TemplateName: "welcome",
+				TemplateData: models.TemplateData{Body: models.TemplateBody{Placeholders: []string{"Ann"}}},
+				Language:     "en",
+			},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bulk", bulk.BulkID)
+	require.Len(t, bulk.Messages, 1)
+
+	msgs := server.Messages()
+	require.Len(t, msgs, 2)
+	assert.Equal(t, ChannelWhatsApp, msgs[0].Channel)
+	assert.Equal(t, "Hello", msgs[0].Text)
+	assert.Equal(t, "whatsapp/1/message/text", msgs[0].Path)
+	assert.Equal(t, "welcome", msgs[1].Text)
+	assert.Equal(t, "bulk", msgs[1].BulkID)
+}
+
+func TestWATemplates(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	sender := "441134960000"
+
+	template, respDetails, err := client.WhatsApp.CreateTemplate(ctx, sender, models.TemplateCreate{
+		Name:     "welcome",
+		Language: "en",
+		Category: "UTILITY",
+		Structure: models.TemplateStructure{
+			Body: &models.TemplateStructureBody{Text: "Hello {{1}}"},
+			Type: "TEXT",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.TemplateStatusPending, template.Status)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = server.SetTemplateStatus(sender, template.ID, models.TemplateStatusApproved, "")
+	}()
+	approved, err := client.WhatsApp.WaitForTemplateStatus(ctx, sender, template.ID, 5*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, models.TemplateStatusApproved, approved.Status)
+
+	edited, _, err := client.WhatsApp.EditTemplate(ctx, sender, template.ID, models.TemplateEdit{
+		Structure: models.TemplateStructure{Body: &models.TemplateStructureBody{Text: "Hi {{1}}"}, Type: "TEXT"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.TemplateStatusPending, edited.Status)
+	assert.Equal(t, "Hi {{1}}", edited.Structure.Body.Text)
+
+	templates, _, err := client.WhatsApp.GetTemplates(ctx, sender)
+	require.NoError(t, err)
+	assert.Len(t, templates.Templates, 1)
+
+	respDetails, err = client.WhatsApp.DeleteTemplate(ctx, sender, "welcome")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+
+	_, respDetails, err = client.WhatsApp.GetTemplate(ctx, sender, template.ID)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+	assert.Error(t, server.SetTemplateStatus(sender, template.ID, models.TemplateStatusApproved, ""))
+}
+
+func TestWAMedia(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	sender := "441134960000"
+	content := []byte("%PDF-1.4 some document")
+
+	uploaded, respDetails, err := client.WhatsApp.UploadMedia(ctx, sender, models.WAMediaUpload{
+		Filename:    "document.pdf",
+		ContentType: "application/pdf",
+		Media:       bytes.NewReader(content),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	require.NotEmpty(t, uploaded.URL)
+
+	var downloaded bytes.Buffer
+	_, err = client.WhatsApp.DownloadMediaByURL(ctx, uploaded.URL, &downloaded)
+	require.NoError(t, err)
+	assert.Equal(t, content, downloaded.Bytes())
+
+	inboundURL := server.AddMedia(sender, "image/png", []byte("some image"))
+	mediaID := inboundURL[strings.LastIndex(inboundURL, "/")+1:]
+	metadata, _, err := client.WhatsApp.GetMediaMetadata(ctx, sender, mediaID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WAMediaMetadata{ContentType: "image/png", ContentLength: 10}, metadata)
+	downloaded.Reset()
+	_, err = client.WhatsApp.DownloadMedia(ctx, sender, mediaID, &downloaded)
+	require.NoError(t, err)
+	assert.Equal(t, "some image", downloaded.String())
+
+	respDetails, err = client.WhatsApp.DeleteMedia(ctx, sender, models.DeleteWAMediaRequest{URL: uploaded.URL})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+	respDetails, err = client.WhatsApp.DownloadMediaByURL(ctx, uploaded.URL, &downloaded)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+	respDetails, err = client.WhatsApp.DeleteMedia(ctx, sender, models.DeleteWAMediaRequest{URL: uploaded.URL})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestWASenders(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	sender := "441134960000"
+
+	registered, respDetails, err := client.WhatsApp.RegisterSender(ctx, models.RegisterWASenderRequest{
+		PhoneNumber:        sender,
+		DisplayName:        "Some Company",
+		VerificationMethod: "SMS",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "PENDING_VERIFICATION", registered.Status)
+
+	_, respDetails, err = client.WhatsApp.VerifySender(ctx, sender, models.VerifyWASenderRequest{Code: "000000"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, respDetails.HTTPResponse.StatusCode)
+	code, ok := server.VerificationCode(sender)
+	require.True(t, ok)
+	verified, _, err := client.WhatsApp.VerifySender(ctx, sender, models.VerifyWASenderRequest{Code: code})
+	require.NoError(t, err)
+	assert.Equal(t, "CONNECTED", verified.Status)
+
+	server.AddSender(models.WASender{Sender: "441134960001", QualityRating: "YELLOW"})
+	senders, _, err := client.WhatsApp.ListSenders(ctx)
+	require.NoError(t, err)
+	require.Len(t, senders.Senders, 2)
+	assert.Equal(t, "Some Company", senders.Senders[0].DisplayName)
+	quality, _, err := client.WhatsApp.GetSenderQuality(ctx, "441134960001")
+	require.NoError(t, err)
+	assert.Equal(t, "YELLOW", quality.QualityRating)
+	assert.Equal(t, "TIER_1K", quality.MessagingLimit)
+	_, respDetails, err = client.WhatsApp.GetSenderQuality(ctx, "441134960002")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestWABusinessProfile(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	sender := "441134960000"
+	server.AddSender(models.WASender{Sender: sender})
+
+	respDetails, err := client.WhatsApp.UpdateBusinessProfile(ctx, sender, models.WABusinessProfile{
+		About:    "Some about",
+		Websites: []string{"https://example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+	_, err = client.WhatsApp.UpdateBusinessProfile(ctx, sender, models.WABusinessProfile{Email: "info@example.com"})
+	require.NoError(t, err)
+
+	photo := append([]byte("\x89PNG\x0D\x0A\x1A\x0A"), bytes.Repeat([]byte{0}, 16)...)
+	respDetails, err = client.WhatsApp.UploadBusinessProfilePhoto(ctx, sender, models.WABusinessProfilePhoto{
+		Filename: "logo.png",
+		Photo:    bytes.NewReader(photo),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+
+	profile, _, err := client.WhatsApp.GetBusinessProfile(ctx, sender)
+	require.NoError(t, err)
+	assert.Equal(t, "Some about", profile.About)
+	assert.Equal(t, "info@example.com", profile.Email)
+	assert.Equal(t, []string{"https://example.com"}, profile.Websites)
+	var downloaded bytes.Buffer
+	_, err = client.WhatsApp.DownloadMediaByURL(ctx, profile.PhotoURL, &downloaded)
+	require.NoError(t, err)
+	assert.Equal(t, photo, downloaded.Bytes())
+
+	_, respDetails, err = client.WhatsApp.GetBusinessProfile(ctx, "441134960001")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestWAIdentity(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	sender, user := "441134960000", "441134960001"
+
+	identity, _, err := client.WhatsApp.GetIdentity(ctx, sender, user)
+	require.NoError(t, err)
+	assert.True(t, identity.Acknowledged)
+
+	server.ChangeIdentity(sender, user)
+	changed, _, err := client.WhatsApp.GetIdentity(ctx, sender, user)
+	require.NoError(t, err)
+	assert.False(t, changed.Acknowledged)
+	assert.NotEqual(t, identity.Hash, changed.Hash)
+
+	confirm := models.ConfirmWAIdentityRequest{Hash: identity.Hash}
+	respDetails, err := client.WhatsApp.ConfirmIdentity(ctx, sender, user, confirm)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, respDetails.HTTPResponse.StatusCode)
+	confirm.Hash = changed.Hash
+	respDetails, err = client.WhatsApp.ConfirmIdentity(ctx, sender, user, confirm)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+
+	confirmed, _, err := client.WhatsApp.GetIdentity(ctx, sender, user)
+	require.NoError(t, err)
+	assert.True(t, confirmed.Acknowledged)
+}
+
+func TestWAPayments(t *testing.T) {
+	server := NewServer(apiKey)
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	sender := "441134960000"
+
+	_, _, err := client.WhatsApp.SendInteractiveOrderDetails(ctx, models.WAInteractiveOrderDetailsMsg{
+		MsgCommon: models.MsgCommon{From: sender, To: "441134960001"},
+		Content: models.InteractiveOrderDetailsContent{
+			Body: models.InteractiveOrderDetailsBody{Text: "Your order is ready for payment."},
+			Action: models.InteractiveOrderDetailsAction{
+				ReferenceID:          "order-42",
+				Type:                 "DIGITAL_GOODS",
+				PaymentConfiguration: "checkout",
+				Currency:             "INR",
+				TotalAmount:          models.InteractiveOrderAmount{Value: 11000, Offset: 100},
+				Order: models.InteractiveOrder{
+					Items: []models.InteractiveOrderItem{{
+						RetailerID: "sku-1",
+						Name:       "Gift card",
+						Amount:     models.InteractiveOrderAmount{Value: 5000, Offset: 100},
+						Quantity:   2,
+					}},
+					Subtotal: models.InteractiveOrderAmount{Value: 10000, Offset: 100},
+					Tax:      models.InteractiveOrderAmount{Value: 1000, Offset: 100},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	payment, _, err := client.WhatsApp.GetPaymentStatus(ctx, sender, "order-42")
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", payment.Status)
+	assert.Equal(t, "INR", payment.Currency)
+	assert.Equal(t, models.InteractiveOrderAmount{Value: 11000, Offset: 100}, payment.TotalAmount)
+
+	require.NoError(t, server.SetPaymentStatus(sender, "order-42", "CAPTURED"))
+	payment, _, err = client.WhatsApp.GetPaymentStatus(ctx, sender, "order-42")
+	require.NoError(t, err)
+	assert.Equal(t, "CAPTURED", payment.Status)
+	assert.NotEmpty(t, payment.TransactionID)
+
+	_, respDetails, err := client.WhatsApp.GetPaymentStatus(ctx, sender, "order-43")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+	assert.Error(t, server.SetPaymentStatus(sender, "order-43", "CAPTURED"))
+}
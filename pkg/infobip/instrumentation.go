@@ -0,0 +1,24 @@
+package infobip
+
+import "github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+
+// Instrumentation is notified of every operation executed by the client, e.g. to create tracing spans and record
+// metrics. An operation covers all the attempts made for a single method call. See the telemetry package for an
+// implementation which can be bridged to OpenTelemetry.
+type Instrumentation = internal.Instrumentation
+
+// OperationSpan is ended once the operation is done.
+type OperationSpan = internal.OperationSpan
+
+// OperationStart describes an operation which is about to be executed.
+type OperationStart = internal.OperationStart
+
+// OperationEnd describes the outcome of an operation.
+type OperationEnd = internal.OperationEnd
+
+// WithInstrumentation makes every channel of the client report its operations to the given instrumentation.
+func WithInstrumentation(instrumentation Instrumentation) func(*Client) {
+	return func(c *Client) {
+		c.instrumentation = instrumentation
+	}
+}
@@ -0,0 +1,17 @@
+package infobip
+
+import "github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+
+// Logger receives debug logs of every request sent and response received. Key-value pairs follow the message,
+// e.g. "method", "POST". It is satisfied by *slog.Logger, among others.
+type Logger = internal.Logger
+
+// WithLogger makes every channel of the client log its requests and responses at debug level, once per attempt.
+// Secrets are redacted from the logs: the credentials of the Authorization header, the username and password
+// query parameters, WebRTC APNs certificates, passwords and FCM server keys, and 2FA PIN codes.
+// Multipart bodies, which may carry files, are only logged by size.
+func WithLogger(logger Logger) func(*Client) {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
@@ -0,0 +1,50 @@
+package infobip
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+)
+
+// Doer executes HTTP requests. It is implemented by *http.Client.
+type Doer = internal.Doer
+
+// DoerFunc adapts a function to the Doer interface.
+type DoerFunc = internal.DoerFunc
+
+// Middleware wraps the Doer which executes a request. It sees the fully built request, including headers,
+// and can read the operation being executed from the request context using OperationFromContext.
+type Middleware = internal.Middleware
+
+// Operation identifies the SDK method which issued a request, e.g. "SMS.Send" or "WhatsApp.SendTemplate".
+type Operation = internal.Operation
+
+// WithMiddleware wraps the HTTP client of every channel in the given middlewares, the first one being the
+// outermost. Middlewares run once per attempt, after waiting on the rate limit, if any.
+func WithMiddleware(middlewares ...Middleware) func(*Client) {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
+// OperationFromContext returns the operation which issued a request, given the request context.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	return internal.OperationFromContext(ctx)
+}
+
+// HeaderMiddleware returns a middleware which sets the given headers on every request.
+func HeaderMiddleware(headers http.Header) Middleware {
+	return internal.HeaderMiddleware(headers)
+}
+
+// RequestIDMiddleware returns a middleware which sets the X-Request-Id header on every request. The ID is taken
+// from the request context when set with ContextWithRequestID, and randomly generated for every attempt otherwise.
+func RequestIDMiddleware() Middleware {
+	return internal.RequestIDMiddleware()
+}
+
+// ContextWithRequestID returns a copy of ctx carrying the request ID to be sent by RequestIDMiddleware.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return internal.WithRequestID(ctx, requestID)
+}
@@ -0,0 +1,112 @@
+package mms
+
+import (
+	"context"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// DeliveryReportIterator iterates over delivery reports, fetching pages of at most params.Limit reports until no
+// more are returned. As reports are returned only once, the reports it returns are not returned by
+// GetDeliveryReports afterwards, and no more reports than the maximum of the iteration are fetched.
+//
+// Unsuccessful responses end the iteration with an *infobip.APIError.
+type DeliveryReportIterator struct {
+	pager *internal.Pager
+	page  []models.OutboundMMSDeliveryResult
+}
+
+// NewDeliveryReportIterator creates a DeliveryReportIterator returning at most maxResults reports, or all of them
+// when maxResults is not positive.
+func NewDeliveryReportIterator(
+	ctx context.Context,
+	mms MMS,
+	params models.GetMMSDeliveryReportsParams,
+	maxResults int,
+) *DeliveryReportIterator {
+	it := &DeliveryReportIterator{}
+	limit := params.Limit
+	it.pager = internal.NewPager(ctx, maxResults, func(ctx context.Context, remaining int) (int, bool, error) {
+		params.Limit = internal.PageLimit(limit, remaining)
+		resp, respDetails, err := mms.GetDeliveryReports(ctx, params)
+		if err == nil {
+			err = internal.ResponseError(respDetails)
+		}
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = resp.Results
+
+		return len(it.page), internal.FullPage(len(it.page), params.Limit), nil
+	})
+
+	return it
+}
+
+// Next advances to the next report, fetching the next page when needed. It returns false once the iteration is
+// over.
+func (it *DeliveryReportIterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Value returns the current report.
+func (it *DeliveryReportIterator) Value() models.OutboundMMSDeliveryResult {
+	return it.page[it.pager.Index()]
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *DeliveryReportIterator) Err() error {
+	return it.pager.Err()
+}
+
+// InboundMessageIterator iterates over inbound messages, fetching pages of at most params.Limit messages until no
+// more are returned. As inbound messages are returned only once, the messages it returns are not returned by
+// GetInboundMessages afterwards, and no more messages than the maximum of the iteration are fetched.
+type InboundMessageIterator struct {
+	pager *internal.Pager
+	page  []models.InboundMMSResult
+}
+
+// NewInboundMessageIterator creates an InboundMessageIterator returning at most maxResults messages, or all of them
+// when maxResults is not positive.
+func NewInboundMessageIterator(
+	ctx context.Context,
+	mms MMS,
+	params models.GetInboundMMSParams,
+	maxResults int,
+) *InboundMessageIterator {
+	it := &InboundMessageIterator{}
+	limit := params.Limit
+	it.pager = internal.NewPager(ctx, maxResults, func(ctx context.Context, remaining int) (int, bool, error) {
+		params.Limit = internal.PageLimit(limit, remaining)
+		resp, respDetails, err := mms.GetInboundMessages(ctx, params)
+		if err == nil {
+			err = internal.ResponseError(respDetails)
+		}
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = resp.Results
+
+		return len(it.page), internal.FullPage(len(it.page), params.Limit), nil
+	})
+
+	return it
+}
+
+// Next advances to the next message, fetching the next page when needed. It returns false once the iteration is
+// over.
+func (it *InboundMessageIterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Value returns the current message.
+func (it *InboundMessageIterator) Value() models.InboundMMSResult {
+	return it.page[it.pager.Index()]
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *InboundMessageIterator) Err() error {
+	return it.pager.Err()
+}
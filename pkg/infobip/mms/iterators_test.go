@@ -0,0 +1,60 @@
+package mms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterators(t *testing.T) {
+	pending := 3
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		require.NoError(t, err)
+
+		switch r.URL.Path {
+		case "/" + getOutboundMMSDeliveryReportsPath:
+			resp := models.GetMMSDeliveryReportsResponse{Results: []models.OutboundMMSDeliveryResult{}}
+			for ; pending > 0 && len(resp.Results) < limit; pending-- {
+				resp.Results = append(resp.Results, models.OutboundMMSDeliveryResult{MessageID: fmt.Sprint(pending)})
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(resp))
+		case "/" + getInboundMMSPath:
+			assert.NoError(t, json.NewEncoder(w).Encode(models.GetInboundMMSResponse{
+				Results: []models.InboundMMSResult{{MessageID: "inbound"}},
+			}))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer serv.Close()
+	channel := &Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     "secret",
+	}}
+
+	reports := NewDeliveryReportIterator(context.Background(), channel, models.GetMMSDeliveryReportsParams{Limit: 2}, 0)
+	var ids []string
+	for reports.Next() {
+		ids = append(ids, reports.Value().MessageID)
+	}
+	require.NoError(t, reports.Err())
+	assert.Equal(t, []string{"3", "2", "1"}, ids)
+
+	// The iteration stops at its maximum without fetching another page.
+	inbound := NewInboundMessageIterator(context.Background(), channel, models.GetInboundMMSParams{}, 1)
+	require.True(t, inbound.Next())
+	assert.Equal(t, "inbound", inbound.Value().MessageID)
+	assert.False(t, inbound.Next())
+	require.NoError(t, inbound.Err())
+}
@@ -32,6 +32,8 @@ func (mms *Channel) Send(
 	ctx context.Context,
 	msg models.MMSMsg,
 ) (msgResp models.SendMMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "MMS.Send", sendMessagePath)
+
 	respDetails, err = mms.ReqHandler.PostMultipartReq(ctx, &msg, &msgResp, sendMessagePath)
 	return msgResp, respDetails, err
 }
@@ -40,6 +42,8 @@ func (mms *Channel) GetDeliveryReports(
 	ctx context.Context,
 	queryParams models.GetMMSDeliveryReportsParams,
 ) (msgResp models.GetMMSDeliveryReportsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "MMS.GetDeliveryReports", getOutboundMMSDeliveryReportsPath)
+
 	params := []internal.QueryParameter{
 		{Name: "bulkId", Value: queryParams.BulkID},
 		{Name: "messageId", Value: queryParams.MessageID},
@@ -56,6 +60,8 @@ func (mms *Channel) GetInboundMessages(
 	ctx context.Context,
 	queryParams models.GetInboundMMSParams,
 ) (msgResp models.GetInboundMMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "MMS.GetInboundMessages", getInboundMMSPath)
+
 	var params []internal.QueryParameter
 	if queryParams.Limit > 0 {
 		params = append(params, internal.QueryParameter{Name: "limit", Value: fmt.Sprint(queryParams.Limit)})
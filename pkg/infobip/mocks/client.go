@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip"
+)
+
+// Channels are fakes of all the channels of a client.
+type Channels struct {
+	WhatsApp *WhatsApp
+	MMS      *MMS
+	Email    *Email
+	SMS      *SMS
+	WebRTC   *WebRTC
+	RCS      *RCS
+}
+
+// NewClient returns a client whose channels are all fakes, and the fakes, to script their responses and inspect
+// the calls made to them.
+func NewClient() (infobip.Client, *Channels) {
+	channels := &Channels{
+		WhatsApp: &WhatsApp{},
+		MMS:      &MMS{},
+		Email:    &Email{},
+		SMS:      &SMS{},
+		WebRTC:   &WebRTC{},
+		RCS:      &RCS{},
+	}
+
+	return infobip.NewClientWithChannels(infobip.Channels{
+		WhatsApp: channels.WhatsApp,
+		MMS:      channels.MMS,
+		Email:    channels.Email,
+		SMS:      channels.SMS,
+		WebRTC:   channels.WebRTC,
+		RCS:      channels.RCS,
+	}), channels
+}
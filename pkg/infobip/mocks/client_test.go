@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	client, channels := NewClient()
+	channels.RCS.Respond("Send", Response{Resource: models.SendRCSResponse{}})
+	ctx := context.Background()
+
+	_, _, err := client.RCS.Send(ctx, models.RCSMsg{To: "41793026727"})
+	require.NoError(t, err)
+	_, err = client.WebRTC.DeleteApplication(ctx, "app")
+	require.NoError(t, err)
+	_, _, err = client.MMS.GetInboundMessages(ctx, models.GetInboundMMSParams{Limit: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, []interface{}{models.RCSMsg{To: "41793026727"}}, channels.RCS.CallsTo("Send")[0].Args)
+	assert.Equal(t, "DeleteApplication", channels.WebRTC.Calls()[0].Method)
+	assert.Len(t, channels.MMS.Calls(), 1)
+	assert.Empty(t, channels.SMS.Calls())
+	assert.Same(t, channels.WhatsApp, client.WhatsApp)
+	assert.Same(t, channels.Email, client.Email)
+}
@@ -0,0 +1,128 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/email"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+var _ email.Email = (*Email)(nil)
+
+// Email is a recording fake of email.Email.
+type Email struct {
+	Recorder
+}
+
+func (m *Email) GetDeliveryReports(
+	ctx context.Context,
+	queryParams models.GetEmailDeliveryReportsParams,
+) (resp models.GetEmailDeliveryReportsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetDeliveryReports", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *Email) GetLogs(
+	ctx context.Context,
+	queryParams models.GetEmailLogsParams,
+) (resp models.GetEmailLogsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetLogs", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *Email) GetSentBulks(
+	ctx context.Context,
+	queryParams models.GetSentEmailBulksParams,
+) (resp models.SentEmailBulksResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetSentBulks", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *Email) GetSentBulksStatus(
+	ctx context.Context,
+	queryParams models.GetSentEmailBulksStatusParams,
+) (resp models.SentEmailBulksStatusResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetSentBulksStatus", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *Email) RescheduleMessages(
+	ctx context.Context,
+	req models.RescheduleEmailRequest,
+	queryParams models.RescheduleEmailParams,
+) (resp models.RescheduleEmailResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "RescheduleMessages", &resp, req, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *Email) Send(
+	ctx context.Context,
+	req models.EmailMsg,
+) (resp models.SendEmailResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "Send", &resp, req)
+	return resp, respDetails, err
+}
+
+func (m *Email) UpdateScheduledMessagesStatus(
+	ctx context.Context,
+	req models.UpdateScheduledEmailStatusRequest,
+	queryParams models.UpdateScheduledEmailStatusParams,
+) (resp models.UpdateScheduledStatusResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "UpdateScheduledMessagesStatus", &resp, req, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *Email) ValidateAddresses(
+	ctx context.Context,
+	req models.ValidateEmailAddressesRequest,
+) (resp models.ValidateEmailAddressesResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "ValidateAddresses", &resp, req)
+	return resp, respDetails, err
+}
+
+func (m *Email) GetDomains(
+	ctx context.Context,
+	queryParams models.GetEmailDomainsParams,
+) (resp models.GetEmailDomainsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetDomains", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *Email) AddDomain(
+	ctx context.Context,
+	req models.AddEmailDomainRequest,
+) (resp models.AddEmailDomainResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "AddDomain", &resp, req)
+	return resp, respDetails, err
+}
+
+func (m *Email) GetDomain(
+	ctx context.Context,
+	domainName string,
+) (resp models.GetEmailDomainResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetDomain", &resp, domainName)
+	return resp, respDetails, err
+}
+
+func (m *Email) DeleteDomain(
+	ctx context.Context,
+	domainName string,
+) (models.ResponseDetails, error) {
+	return m.record(ctx, "DeleteDomain", nil, domainName)
+}
+
+func (m *Email) UpdateDomainTracking(
+	ctx context.Context,
+	domainName string,
+	req models.UpdateEmailDomainTrackingRequest,
+) (resp models.UpdateEmailDomainTrackingResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "UpdateDomainTracking", &resp, domainName, req)
+	return resp, respDetails, err
+}
+
+func (m *Email) VerifyDomain(
+	ctx context.Context,
+	domainName string,
+) (models.ResponseDetails, error) {
+	return m.record(ctx, "VerifyDomain", nil, domainName)
+}
@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/mms"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+var _ mms.MMS = (*MMS)(nil)
+
+// MMS is a recording fake of mms.MMS.
+type MMS struct {
+	Recorder
+}
+
+func (m *MMS) Send(
+	ctx context.Context,
+	msg models.MMSMsg,
+) (resp models.SendMMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "Send", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *MMS) GetDeliveryReports(
+	ctx context.Context,
+	queryParams models.GetMMSDeliveryReportsParams,
+) (resp models.GetMMSDeliveryReportsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetDeliveryReports", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *MMS) GetInboundMessages(
+	ctx context.Context,
+	queryParams models.GetInboundMMSParams,
+) (resp models.GetInboundMMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetInboundMessages", &resp, queryParams)
+	return resp, respDetails, err
+}
@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/rcs"
+)
+
+var _ rcs.RCS = (*RCS)(nil)
+
+// RCS is a recording fake of rcs.RCS.
+type RCS struct {
+	Recorder
+}
+
+func (m *RCS) Send(
+	ctx context.Context,
+	msg models.RCSMsg,
+) (resp models.SendRCSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "Send", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *RCS) SendBulk(
+	ctx context.Context,
+	req models.SendRCSBulkRequest,
+) (resp models.SendRCSBulkResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendBulk", &resp, req)
+	return resp, respDetails, err
+}
+
+func (m *RCS) GetDeliveryReports(
+	ctx context.Context,
+	queryParams models.GetRCSDeliveryReportsParams,
+) (resp models.GetRCSDeliveryReportsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetDeliveryReports", &resp, queryParams)
+	return resp, respDetails, err
+}
@@ -0,0 +1,131 @@
+// Package mocks provides recording fakes of the channel interfaces, to unit test code using this SDK without HTTP.
+//
+// Every fake records the calls made to it and returns the responses scripted for each method with Respond, or
+// empty resources with a 200 OK status when none were scripted:
+//
+//	client, channels := mocks.NewClient()
+//	channels.SMS.Respond("Send", mocks.Response{Resource: models.SendSMSResponse{BulkID: "bulk"}})
+//	notifyUser(client)
+//	calls := channels.SMS.CallsTo("Send")
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// Call is a call made to a fake.
+type Call struct {
+	Method string
+	Ctx    context.Context
+	// Args are the arguments of the call, except the context.
+	Args []interface{}
+}
+
+// Response is a response scripted for a method of a fake.
+type Response struct {
+	// Resource is the resource returned by the method, e.g. a models.SendSMSResponse for SMS.Send. It has to be of
+	// the type returned by the method, and is ignored by methods returning no resource.
+	Resource interface{}
+	// Details are the returned response details. A zero status code is returned as 200 OK.
+	Details models.ResponseDetails
+	Err     error
+}
+
+// Recorder records the calls made to a fake and returns their scripted responses. It is safe for concurrent use.
+type Recorder struct {
+	mu        sync.Mutex
+	calls     []Call
+	responses map[string][]Response
+}
+
+// Respond scripts the responses of a method. Calls get the responses in order, and the last one is returned again
+// once all were used. Responses scripted again replace the previous ones.
+func (r *Recorder) Respond(method string, responses ...Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.responses == nil {
+		r.responses = map[string][]Response{}
+	}
+	r.responses[method] = responses
+}
+
+// Calls returns all the calls made, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call{}, r.calls...)
+}
+
+// CallsTo returns the calls made to a method, in order.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the calls made and the scripted responses.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+	r.responses = nil
+}
+
+// record records a call and returns its response, setting the resource it points to, if any.
+func (r *Recorder) record(
+	ctx context.Context,
+	method string,
+	resource interface{},
+	args ...interface{},
+) (models.ResponseDetails, error) {
+	resp := r.next(ctx, method, args)
+
+	if resource != nil && resp.Resource != nil {
+		target := reflect.ValueOf(resource).Elem()
+		value := reflect.ValueOf(resp.Resource)
+		if !value.Type().AssignableTo(target.Type()) {
+			panic(fmt.Sprintf("mocks: %s returns %s, not %T", method, target.Type(), resp.Resource))
+		}
+		target.Set(value)
+	}
+
+	return resp.Details, resp.Err
+}
+
+// next records a call and returns its scripted response.
+func (r *Recorder) next(ctx context.Context, method string, args []interface{}) Response {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Ctx: ctx, Args: args})
+	var resp Response
+	if responses := r.responses[method]; len(responses) > 0 {
+		resp = responses[0]
+		if len(responses) > 1 {
+			r.responses[method] = responses[1:]
+		}
+	}
+	if resp.Details.HTTPResponse.StatusCode == 0 {
+		resp.Details.HTTPResponse.StatusCode = http.StatusOK
+		resp.Details.HTTPResponse.Status = "200 OK"
+	}
+
+	return resp
+}
@@ -0,0 +1,107 @@
+package mocks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderDefaultResponse(t *testing.T) {
+	fake := &SMS{}
+
+	resp, respDetails, err := fake.Send(context.Background(), models.SendSMSRequest{BulkID: "bulk"})
+
+	require.NoError(t, err)
+	assert.Equal(t, models.SendSMSResponse{}, resp)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestRecorderScriptedResponses(t *testing.T) {
+	fake := &SMS{}
+	sendErr := errors.New("network down")
+	fake.Respond("Send",
+		Response{Err: sendErr},
+		Response{
+			Details: models.ResponseDetails{HTTPResponse: http.Response{StatusCode: http.StatusTooManyRequests}},
+		},
+		Response{Resource: models.SendSMSResponse{BulkID: "bulk"}},
+	)
+	ctx := context.Background()
+
+	_, _, err := fake.Send(ctx, models.SendSMSRequest{})
+	assert.Equal(t, sendErr, err)
+
+	_, respDetails, err := fake.Send(ctx, models.SendSMSRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, respDetails.HTTPResponse.StatusCode)
+
+	for i := 0; i < 2; i++ {
+		resp, respDetails, err := fake.Send(ctx, models.SendSMSRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+		assert.Equal(t, "bulk", resp.BulkID)
+	}
+
+	_, _, err = fake.GetLogs(ctx, models.GetSMSLogsParams{})
+	assert.NoError(t, err)
+}
+
+func TestRecorderCalls(t *testing.T) {
+	fake := &SMS{}
+	ctx := context.Background()
+
+	_, _, _ = fake.Send(ctx, models.SendSMSRequest{BulkID: "first"})
+	_, _, _ = fake.UpdateTFAApplication(ctx, "app", models.UpdateTFAApplicationRequest{Name: "name"})
+	_, _, _ = fake.Send(ctx, models.SendSMSRequest{BulkID: "second"})
+
+	calls := fake.Calls()
+	require.Len(t, calls, 3)
+	assert.Equal(t, "UpdateTFAApplication", calls[1].Method)
+	assert.Equal(t, []interface{}{"app", models.UpdateTFAApplicationRequest{Name: "name"}}, calls[1].Args)
+	assert.Equal(t, ctx, calls[1].Ctx)
+
+	sends := fake.CallsTo("Send")
+	require.Len(t, sends, 2)
+	assert.Equal(t, models.SendSMSRequest{BulkID: "second"}, sends[1].Args[0])
+
+	fake.Respond("Send", Response{Err: errors.New("failed")})
+	fake.Reset()
+	assert.Empty(t, fake.Calls())
+	_, _, err := fake.Send(ctx, models.SendSMSRequest{})
+	assert.NoError(t, err)
+}
+
+func TestRecorderWrongResourceType(t *testing.T) {
+	fake := &SMS{}
+	fake.Respond("Send", Response{Resource: models.GetSMSLogsResponse{}})
+
+	assert.PanicsWithValue(t, "mocks: Send returns models.SendSMSResponse, not models.GetSMSLogsResponse", func() {
+		_, _, _ = fake.Send(context.Background(), models.SendSMSRequest{})
+	})
+}
+
+func TestRecorderConcurrentCalls(t *testing.T) {
+	fake := &Email{}
+	fake.Respond("ValidateAddresses", Response{Resource: models.ValidateEmailAddressesResponse{ValidSyntax: true}})
+	calls := 20
+
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, _, err := fake.ValidateAddresses(context.Background(), models.ValidateEmailAddressesRequest{})
+			assert.NoError(t, err)
+			assert.True(t, resp.ValidSyntax)
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, fake.CallsTo("ValidateAddresses"), calls)
+}
@@ -0,0 +1,242 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/sms"
+)
+
+var _ sms.SMS = (*SMS)(nil)
+
+// SMS is a recording fake of sms.SMS.
+type SMS struct {
+	Recorder
+}
+
+func (m *SMS) Send(
+	ctx context.Context,
+	req models.SendSMSRequest,
+) (resp models.SendSMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "Send", &resp, req)
+	return resp, respDetails, err
+}
+
+// SendBulk returns the scripted sms.BulkResponse, or a single result with the details and error of the scripted
+// response when it has no resource.
+func (m *SMS) SendBulk(
+	ctx context.Context,
+	req models.SendSMSRequest,
+	opts sms.BulkOptions,
+) (resp sms.BulkResponse) {
+	respDetails, err := m.record(ctx, "SendBulk", &resp, req, opts)
+	if resp.Results == nil {
+		resp.BulkID = req.BulkID
+		resp.Results = []sms.BulkResult{{Request: req, ResponseDetails: respDetails, Err: err}}
+	}
+
+	return resp
+}
+
+func (m *SMS) SendBinary(
+	ctx context.Context,
+	req models.SendBinarySMSRequest,
+) (resp models.SendBinarySMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendBinary", &resp, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) SendOverQueryParams(
+	ctx context.Context,
+	queryParams models.SendSMSOverQueryParamsParams,
+) (resp models.SendSMSOverQueryParamsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendOverQueryParams", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *SMS) Preview(
+	ctx context.Context,
+	req models.PreviewSMSRequest,
+) (resp models.PreviewSMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "Preview", &resp, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetDeliveryReports(
+	ctx context.Context,
+	queryParams models.GetSMSDeliveryReportsParams,
+) (resp models.GetSMSDeliveryReportsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetDeliveryReports", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetLogs(
+	ctx context.Context,
+	queryParams models.GetSMSLogsParams,
+) (resp models.GetSMSLogsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetLogs", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetInboundMessages(
+	ctx context.Context,
+	queryParams models.GetInboundSMSParams,
+) (resp models.GetInboundSMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetInboundMessages", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetScheduledMessages(
+	ctx context.Context,
+	queryParams models.GetScheduledSMSParams,
+) (resp models.GetScheduledSMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetScheduledMessages", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *SMS) RescheduleMessages(
+	ctx context.Context,
+	req models.RescheduleSMSRequest,
+	queryParams models.RescheduleSMSParams,
+) (resp models.RescheduleSMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "RescheduleMessages", &resp, req, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetScheduledMessagesStatus(
+	ctx context.Context,
+	queryParams models.GetScheduledSMSStatusParams,
+) (resp models.GetScheduledSMSStatusResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetScheduledMessagesStatus", &resp, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *SMS) UpdateScheduledMessagesStatus(
+	ctx context.Context,
+	req models.UpdateScheduledSMSStatusRequest,
+	queryParams models.UpdateScheduledSMSStatusParams,
+) (resp models.UpdateScheduledSMSStatusResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "UpdateScheduledMessagesStatus", &resp, req, queryParams)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetTFAApplications(
+	ctx context.Context,
+) (resp models.GetTFAApplicationsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetTFAApplications", &resp)
+	return resp, respDetails, err
+}
+
+func (m *SMS) CreateTFAApplication(
+	ctx context.Context,
+	req models.CreateTFAApplicationRequest,
+) (resp models.CreateTFAApplicationResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "CreateTFAApplication", &resp, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetTFAApplication(
+	ctx context.Context,
+	appID string,
+) (resp models.GetTFAApplicationResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetTFAApplication", &resp, appID)
+	return resp, respDetails, err
+}
+
+func (m *SMS) UpdateTFAApplication(
+	ctx context.Context,
+	appID string,
+	req models.UpdateTFAApplicationRequest,
+) (resp models.UpdateTFAApplicationResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "UpdateTFAApplication", &resp, appID, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetTFAMessageTemplates(
+	ctx context.Context,
+	appID string,
+) (resp models.GetTFAMessageTemplatesResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetTFAMessageTemplates", &resp, appID)
+	return resp, respDetails, err
+}
+
+func (m *SMS) CreateTFAMessageTemplate(
+	ctx context.Context,
+	appID string,
+	req models.CreateTFAMessageTemplateRequest,
+) (resp models.CreateTFAMessageTemplateResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "CreateTFAMessageTemplate", &resp, appID, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetTFAMessageTemplate(
+	ctx context.Context,
+	appID string,
+	templateID string,
+) (resp models.GetTFAMessageTemplateResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetTFAMessageTemplate", &resp, appID, templateID)
+	return resp, respDetails, err
+}
+
+func (m *SMS) UpdateTFAMessageTemplate(
+	ctx context.Context,
+	appID string,
+	messageID string,
+	req models.UpdateTFAMessageTemplateRequest,
+) (resp models.UpdateTFAMessageTemplateResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "UpdateTFAMessageTemplate", &resp, appID, messageID, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) SendPINOverSMS(
+	ctx context.Context,
+	queryParams models.SendPINOverSMSParams,
+	req models.SendPINOverSMSRequest,
+) (resp models.SendPINOverSMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendPINOverSMS", &resp, queryParams, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) ResendPINOverSMS(
+	ctx context.Context,
+	pinID string,
+	req models.ResendPINOverSMSRequest,
+) (resp models.ResendPINOverSMSResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "ResendPINOverSMS", &resp, pinID, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) SendPINOverVoice(
+	ctx context.Context,
+	req models.SendPINOverVoiceRequest,
+) (resp models.SendPINOverVoiceResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendPINOverVoice", &resp, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) ResendPINOverVoice(
+	ctx context.Context,
+	pinID string,
+	req models.ResendPINOverVoiceRequest,
+) (resp models.ResendPINOverVoiceResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "ResendPINOverVoice", &resp, pinID, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) VerifyPhoneNumber(
+	ctx context.Context,
+	pinID string,
+	req models.VerifyPhoneNumberRequest,
+) (resp models.VerifyPhoneNumberResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "VerifyPhoneNumber", &resp, pinID, req)
+	return resp, respDetails, err
+}
+
+func (m *SMS) GetTFAVerificationStatus(
+	ctx context.Context,
+	appID string,
+	queryParams models.GetTFAVerificationStatusParams,
+) (resp models.GetTFAVerificationStatusResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetTFAVerificationStatus", &resp, appID, queryParams)
+	return resp, respDetails, err
+}
@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/sms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMSSendBulk(t *testing.T) {
+	fake := &SMS{}
+	req := models.SendSMSRequest{BulkID: "bulk"}
+	sendErr := errors.New("failed")
+	fake.Respond("SendBulk", Response{Err: sendErr})
+
+	resp := fake.SendBulk(context.Background(), req, sms.BulkOptions{Workers: 2})
+
+	require.Len(t, resp.Results, 1)
+	assert.Equal(t, sendErr, resp.Results[0].Err)
+	assert.Equal(t, req, resp.Results[0].Request)
+	assert.Equal(t, 1, resp.Failed())
+
+	scripted := sms.BulkResponse{BulkID: "bulk", Results: []sms.BulkResult{{Request: req}}}
+	fake.Respond("SendBulk", Response{Resource: scripted})
+	assert.Equal(t, scripted, fake.SendBulk(context.Background(), req, sms.BulkOptions{}))
+	assert.Equal(t, sms.BulkOptions{Workers: 2}, fake.CallsTo("SendBulk")[0].Args[1])
+}
@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/webrtc"
+)
+
+var _ webrtc.WebRTC = (*WebRTC)(nil)
+
+// WebRTC is a recording fake of webrtc.WebRTC.
+type WebRTC struct {
+	Recorder
+}
+
+func (m *WebRTC) GetApplications(
+	ctx context.Context,
+) (resp models.GetWebRTCApplicationsResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetApplications", &resp)
+	return resp, respDetails, err
+}
+
+func (m *WebRTC) SaveApplication(
+	ctx context.Context,
+	application models.WebRTCApplication,
+) (resp models.SaveWebRTCApplicationResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SaveApplication", &resp, application)
+	return resp, respDetails, err
+}
+
+func (m *WebRTC) GetApplication(
+	ctx context.Context,
+	applicationID string,
+) (resp models.GetWebRTCApplicationResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetApplication", &resp, applicationID)
+	return resp, respDetails, err
+}
+
+func (m *WebRTC) UpdateApplication(
+	ctx context.Context,
+	applicationID string,
+	application models.WebRTCApplication,
+) (resp models.UpdateWebRTCApplicationResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "UpdateApplication", &resp, applicationID, application)
+	return resp, respDetails, err
+}
+
+func (m *WebRTC) DeleteApplication(
+	ctx context.Context,
+	applicationID string,
+) (models.ResponseDetails, error) {
+	return m.record(ctx, "DeleteApplication", nil, applicationID)
+}
+
+func (m *WebRTC) GenerateToken(
+	ctx context.Context,
+	req models.GenerateWebRTCTokenRequest,
+) (resp models.GenerateWebRTCTokenResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GenerateToken", &resp, req)
+	return resp, respDetails, err
+}
@@ -0,0 +1,381 @@
+package mocks
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/whatsapp"
+)
+
+var _ whatsapp.WhatsApp = (*WhatsApp)(nil)
+
+// WhatsApp is a recording fake of whatsapp.WhatsApp.
+type WhatsApp struct {
+	Recorder
+}
+
+func (m *WhatsApp) SendTemplate(
+	ctx context.Context,
+	messages models.WATemplateMsgs,
+) (resp models.BulkWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendTemplate", &resp, messages)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendText(
+	ctx context.Context,
+	msg models.WATextMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendText", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendDocument(
+	ctx context.Context,
+	msg models.WADocumentMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendDocument", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendImage(
+	ctx context.Context,
+	msg models.WAImageMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendImage", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendAudio(
+	ctx context.Context,
+	msg models.WAAudioMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendAudio", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendVideo(
+	ctx context.Context,
+	msg models.WAVideoMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendVideo", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendSticker(
+	ctx context.Context,
+	msg models.WAStickerMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendSticker", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendLocation(
+	ctx context.Context,
+	msg models.WALocationMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendLocation", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendContact(
+	ctx context.Context,
+	msg models.WAContactMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendContact", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendInteractiveButtons(
+	ctx context.Context,
+	msg models.WAInteractiveButtonsMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendInteractiveButtons", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendInteractiveList(
+	ctx context.Context,
+	msg models.WAInteractiveListMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendInteractiveList", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendInteractiveProduct(
+	ctx context.Context,
+	msg models.WAInteractiveProductMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendInteractiveProduct", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendInteractiveMultiproduct(
+	ctx context.Context,
+	msg models.WAInteractiveMultiproductMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendInteractiveMultiproduct", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendInteractiveFlow(
+	ctx context.Context,
+	msg models.WAInteractiveFlowMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendInteractiveFlow", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendInteractiveURLButton(
+	ctx context.Context,
+	msg models.WAInteractiveURLButtonMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendInteractiveURLButton", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendInteractiveLocationRequest(
+	ctx context.Context,
+	msg models.WAInteractiveLocationRequestMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendInteractiveLocationRequest", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendInteractiveOrderDetails(
+	ctx context.Context,
+	msg models.WAInteractiveOrderDetailsMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendInteractiveOrderDetails", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) SendInteractiveOrderStatus(
+	ctx context.Context,
+	msg models.WAInteractiveOrderStatusMsg,
+) (resp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "SendInteractiveOrderStatus", &resp, msg)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) GetPaymentStatus(
+	ctx context.Context,
+	sender string,
+	referenceID string,
+) (resp models.WAPaymentStatus, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetPaymentStatus", &resp, sender, referenceID)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) GetTemplates(
+	ctx context.Context,
+	sender string,
+) (resp models.GetWATemplatesResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetTemplates", &resp, sender)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) CreateTemplate(
+	ctx context.Context,
+	sender string,
+	template models.TemplateCreate,
+) (resp models.CreateWATemplateResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "CreateTemplate", &resp, sender, template)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) DeleteTemplate(
+	ctx context.Context,
+	sender string,
+	templateName string,
+) (models.ResponseDetails, error) {
+	return m.record(ctx, "DeleteTemplate", nil, sender, templateName)
+}
+
+func (m *WhatsApp) GetTemplate(
+	ctx context.Context,
+	sender string,
+	id string,
+) (resp models.CreateWATemplateResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetTemplate", &resp, sender, id)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) EditTemplate(
+	ctx context.Context,
+	sender string,
+	id string,
+	template models.TemplateEdit,
+) (resp models.CreateWATemplateResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "EditTemplate", &resp, sender, id, template)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) UploadMedia(
+	ctx context.Context,
+	sender string,
+	upload models.WAMediaUpload,
+) (resp models.UploadWAMediaResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "UploadMedia", &resp, sender, upload)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) GetMediaMetadata(
+	ctx context.Context,
+	sender string,
+	mediaID string,
+) (resp models.WAMediaMetadata, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetMediaMetadata", &resp, sender, mediaID)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) DeleteMedia(
+	ctx context.Context,
+	sender string,
+	req models.DeleteWAMediaRequest,
+) (models.ResponseDetails, error) {
+	return m.record(ctx, "DeleteMedia", nil, sender, req)
+}
+
+func (m *WhatsApp) ListSenders(
+	ctx context.Context,
+) (resp models.GetWASendersResponse, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "ListSenders", &resp)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) GetSenderQuality(
+	ctx context.Context,
+	sender string,
+) (resp models.WASenderQuality, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetSenderQuality", &resp, sender)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) GetBusinessProfile(
+	ctx context.Context,
+	sender string,
+) (resp models.WABusinessProfile, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetBusinessProfile", &resp, sender)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) UpdateBusinessProfile(
+	ctx context.Context,
+	sender string,
+	profile models.WABusinessProfile,
+) (models.ResponseDetails, error) {
+	return m.record(ctx, "UpdateBusinessProfile", nil, sender, profile)
+}
+
+func (m *WhatsApp) UploadBusinessProfilePhoto(
+	ctx context.Context,
+	sender string,
+	photo models.WABusinessProfilePhoto,
+) (models.ResponseDetails, error) {
+	return m.record(ctx, "UploadBusinessProfilePhoto", nil, sender, photo)
+}
+
+func (m *WhatsApp) RegisterSender(
+	ctx context.Context,
+	req models.RegisterWASenderRequest,
+) (resp models.WASender, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "RegisterSender", &resp, req)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) VerifySender(
+	ctx context.Context,
+	sender string,
+	req models.VerifyWASenderRequest,
+) (resp models.WASender, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "VerifySender", &resp, sender, req)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) MarkAsRead(
+	ctx context.Context,
+	sender string,
+	messageID string,
+) (models.ResponseDetails, error) {
+	return m.record(ctx, "MarkAsRead", nil, sender, messageID)
+}
+
+func (m *WhatsApp) GetIdentity(
+	ctx context.Context,
+	sender string,
+	userNumber string,
+) (resp models.WAIdentity, respDetails models.ResponseDetails, err error) {
+	respDetails, err = m.record(ctx, "GetIdentity", &resp, sender, userNumber)
+	return resp, respDetails, err
+}
+
+func (m *WhatsApp) ConfirmIdentity(
+	ctx context.Context,
+	sender string,
+	userNumber string,
+	req models.ConfirmWAIdentityRequest,
+) (models.ResponseDetails, error) {
+	return m.record(ctx, "ConfirmIdentity", nil, sender, userNumber, req)
+}
+
+// SendBulk returns the scripted whatsapp.BulkResponse, or a result per message with the details and error of the
+// scripted response when it has no resource.
+func (m *WhatsApp) SendBulk(
+	ctx context.Context,
+	msgs []models.Validatable,
+	opts whatsapp.BulkOptions,
+) (resp whatsapp.BulkResponse) {
+	respDetails, err := m.record(ctx, "SendBulk", &resp, msgs, opts)
+	if resp.Results == nil {
+		resp.Results = make([]whatsapp.BulkResult, len(msgs))
+		for i := range resp.Results {
+			resp.Results[i] = whatsapp.BulkResult{ResponseDetails: respDetails, Err: err}
+		}
+	}
+
+	return resp
+}
+
+func (m *WhatsApp) WaitForTemplateStatus(
+	ctx context.Context,
+	sender string,
+	id string,
+	pollInterval time.Duration,
+) (resp models.CreateWATemplateResponse, err error) {
+	_, err = m.record(ctx, "WaitForTemplateStatus", &resp, sender, id, pollInterval)
+	return resp, err
+}
+
+// DownloadMedia writes the scripted resource to w, which has to be a []byte.
+func (m *WhatsApp) DownloadMedia(
+	ctx context.Context,
+	sender string,
+	mediaID string,
+	w io.Writer,
+) (models.ResponseDetails, error) {
+	var content []byte
+	respDetails, err := m.record(ctx, "DownloadMedia", &content, sender, mediaID, w)
+	if err == nil {
+		_, err = w.Write(content)
+	}
+
+	return respDetails, err
+}
+
+// DownloadMediaByURL writes the scripted resource to w, which has to be a []byte.
+func (m *WhatsApp) DownloadMediaByURL(
+	ctx context.Context,
+	mediaURL string,
+	w io.Writer,
+) (models.ResponseDetails, error) {
+	var content []byte
+	respDetails, err := m.record(ctx, "DownloadMediaByURL", &content, mediaURL, w)
+	if err == nil {
+		_, err = w.Write(content)
+	}
+
+	return respDetails, err
+}
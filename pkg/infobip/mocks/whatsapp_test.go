@@ -0,0 +1,64 @@
+package mocks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/whatsapp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWhatsAppSendBulk(t *testing.T) {
+	fake := &WhatsApp{}
+	msgs := []models.Validatable{&models.WATextMsg{}, &models.WAImageMsg{}}
+	sendErr := errors.New("failed")
+	fake.Respond("SendBulk", Response{Err: sendErr})
+
+	resp := fake.SendBulk(context.Background(), msgs, whatsapp.BulkOptions{Workers: 2})
+
+	require.Len(t, resp.Results, 2)
+	assert.Equal(t, sendErr, resp.Results[1].Err)
+	assert.Equal(t, 2, resp.Failed())
+
+	scripted := whatsapp.BulkResponse{Results: []whatsapp.BulkResult{{Response: models.SendWAMsgResponse{To: "1"}}}}
+	fake.Respond("SendBulk", Response{Resource: scripted})
+	assert.Equal(t, scripted, fake.SendBulk(context.Background(), msgs, whatsapp.BulkOptions{}))
+	assert.Equal(t, whatsapp.BulkOptions{Workers: 2}, fake.CallsTo("SendBulk")[0].Args[1])
+}
+
+func TestWhatsAppWaitForTemplateStatus(t *testing.T) {
+	fake := &WhatsApp{}
+	fake.Respond("WaitForTemplateStatus", Response{
+		Resource: models.CreateWATemplateResponse{ID: "1", Status: models.TemplateStatusApproved},
+	})
+
+	template, err := fake.WaitForTemplateStatus(context.Background(), "sender", "1", time.Second)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.TemplateStatusApproved, template.Status)
+	assert.Equal(t, []interface{}{"sender", "1", time.Second}, fake.Calls()[0].Args)
+}
+
+func TestWhatsAppDownloadMedia(t *testing.T) {
+	fake := &WhatsApp{}
+	fake.Respond("DownloadMedia", Response{Resource: []byte("image")})
+	var buf bytes.Buffer
+
+	respDetails, err := fake.DownloadMedia(context.Background(), "sender", "media", &buf)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "image", buf.String())
+
+	fake.Respond("DownloadMediaByURL", Response{Err: errors.New("failed")})
+	buf.Reset()
+	_, err = fake.DownloadMediaByURL(context.Background(), "https://example.com/media", &buf)
+	assert.Error(t, err)
+	assert.Empty(t, buf.String())
+}
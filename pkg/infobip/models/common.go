@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/phonenumber"
 )
 
 const MinsPerHour = 60
@@ -29,6 +30,18 @@ func SetupValidation() {
 	validate = validator.New()
 	setupWhatsAppValidations()
 	setupMMSValidations()
+	setupPhoneNumberValidations()
+}
+
+// setupPhoneNumberValidations registers the e164num tag, which models can add to phone number fields to reject
+// numbers which are not in E.164 format, with or without the leading "+". Destinations of messages do not use it,
+// as the API also accepts short codes and numbers in other formats. See the phonenumber package to normalize
+// numbers before sending them.
+func setupPhoneNumberValidations() {
+	// Registering a valid tag cannot fail.
+	_ = validate.RegisterValidation("e164num", func(fl validator.FieldLevel) bool {
+		return phonenumber.IsE164(fl.Field().String())
+	})
 }
 
 // Validatable should be implemented by all models which represent request payloads.
@@ -43,6 +56,13 @@ type MultipartValidatable interface {
 	GetMultipartBoundary() string
 }
 
+// MultipartStreamValidatable should be implemented by models which represent multipart payloads streamed from
+// a reader, such as file uploads. The payload is written while the request is being sent.
+type MultipartStreamValidatable interface {
+	Validate() error
+	WriteMultipart(writer *multipart.Writer) error
+}
+
 func marshalJSON(t interface{}) (*bytes.Buffer, error) {
 	payload, err := json.Marshal(t)
 	if err != nil {
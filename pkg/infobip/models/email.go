@@ -56,6 +56,16 @@ type SendEmailResponse struct {
 	} `json:"messages"`
 }
 
+// MessageIDs returns the IDs of the sent messages, e.g. to wait for their delivery.
+func (s SendEmailResponse) MessageIDs() []string {
+	messageIDs := make([]string, 0, len(s.Messages))
+	for _, msg := range s.Messages {
+		messageIDs = append(messageIDs, msg.MessageID)
+	}
+
+	return messageIDs
+}
+
 //nolint:cyclop,funlen,gocognit,gocyclo // Because the EmailMsg has too many fields.
 func (e *EmailMsg) Marshal() (*bytes.Buffer, error) {
 	buf := bytes.Buffer{}
@@ -278,35 +288,37 @@ func (e *EmailMsg) GetMultipartBoundary() string {
 }
 
 type GetEmailDeliveryReportsResponse struct {
-	Results []struct {
-		BulkID       string `json:"bulkId"`
-		MessageID    string `json:"messageId"`
-		To           string `json:"to"`
-		SentAt       string `json:"sentAt"`
-		DoneAt       string `json:"doneAt"`
-		MessageCount int    `json:"messageCount"`
-		Price        struct {
-			PricePerMessage float64 `json:"pricePerMessage"`
-			Currency        string  `json:"currency"`
-		} `json:"price"`
-		Status struct {
-			GroupID     int    `json:"groupId"`
-			GroupName   string `json:"groupName"`
-			ID          int    `json:"id"`
-			Name        string `json:"name"`
-			Description string `json:"description"`
-			Action      string `json:"action"`
-		} `json:"status"`
-		Error struct {
-			GroupID     int    `json:"groupId"`
-			GroupName   string `json:"groupName"`
-			ID          int    `json:"id"`
-			Name        string `json:"name"`
-			Description string `json:"description"`
-			Permanent   bool   `json:"permanent"`
-		} `json:"error"`
-		Channel string `json:"channel"`
-	} `json:"results"`
+	Results []EmailDeliveryReport `json:"results"`
+}
+
+type EmailDeliveryReport struct {
+	BulkID       string `json:"bulkId"`
+	MessageID    string `json:"messageId"`
+	To           string `json:"to"`
+	SentAt       string `json:"sentAt"`
+	DoneAt       string `json:"doneAt"`
+	MessageCount int    `json:"messageCount"`
+	Price        struct {
+		PricePerMessage float64 `json:"pricePerMessage"`
+		Currency        string  `json:"currency"`
+	} `json:"price"`
+	Status struct {
+		GroupID     int    `json:"groupId"`
+		GroupName   string `json:"groupName"`
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Action      string `json:"action"`
+	} `json:"status"`
+	Error struct {
+		GroupID     int    `json:"groupId"`
+		GroupName   string `json:"groupName"`
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Permanent   bool   `json:"permanent"`
+	} `json:"error"`
+	Channel string `json:"channel"`
 }
 
 type GetEmailDeliveryReportsParams struct {
@@ -316,29 +328,31 @@ type GetEmailDeliveryReportsParams struct {
 }
 
 type GetEmailLogsResponse struct {
-	Results []struct {
-		MessageID    string `json:"messageId"`
-		To           string `json:"to"`
-		From         string `json:"from"`
-		Text         string `json:"text"`
-		SentAt       string `json:"sentAt"`
-		DoneAt       string `json:"doneAt"`
-		MessageCount int    `json:"messageCount"`
-		Price        struct {
-			PricePerMessage float64 `json:"pricePerMessage"`
-			Currency        string  `json:"currency"`
-		} `json:"price"`
-		Status struct {
-			GroupID     int    `json:"groupId"`
-			GroupName   string `json:"groupName"`
-			ID          int    `json:"id"`
-			Name        string `json:"name"`
-			Description string `json:"description"`
-			Action      string `json:"action"`
-		} `json:"status"`
-		BulkID  string `json:"bulkId"`
-		Channel string `json:"channel"`
-	} `json:"results"`
+	Results []EmailLog `json:"results"`
+}
+
+type EmailLog struct {
+	MessageID    string `json:"messageId"`
+	To           string `json:"to"`
+	From         string `json:"from"`
+	Text         string `json:"text"`
+	SentAt       string `json:"sentAt"`
+	DoneAt       string `json:"doneAt"`
+	MessageCount int    `json:"messageCount"`
+	Price        struct {
+		PricePerMessage float64 `json:"pricePerMessage"`
+		Currency        string  `json:"currency"`
+	} `json:"price"`
+	Status struct {
+		GroupID     int    `json:"groupId"`
+		GroupName   string `json:"groupName"`
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Action      string `json:"action"`
+	} `json:"status"`
+	BulkID  string `json:"bulkId"`
+	Channel string `json:"channel"`
 }
 
 type GetEmailLogsParams struct {
@@ -103,6 +103,16 @@ type SendMMSResponse struct {
 	ErrorMessage string    `json:"errorMessage"`
 }
 
+// MessageIDs returns the IDs of the sent messages, e.g. to wait for their delivery.
+func (s SendMMSResponse) MessageIDs() []string {
+	messageIDs := make([]string, 0, len(s.Messages))
+	for _, msg := range s.Messages {
+		messageIDs = append(messageIDs, msg.MessageID)
+	}
+
+	return messageIDs
+}
+
 type SentMMS struct {
 	To        string    `json:"to"`
 	Status    MMSStatus `json:"status"`
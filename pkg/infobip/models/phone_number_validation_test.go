@@ -0,0 +1,41 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestE164NumValidation(t *testing.T) {
+	type destination struct {
+		To string `validate:"required,e164num"`
+	}
+
+	for _, to := range []string{"41793026727", "+385977666618"} {
+		require.NoError(t, validate.Struct(destination{To: to}), to)
+	}
+	for _, to := range []string{"+1 (555) 123-4567", "00385977666618", "+999123456789"} {
+		assert.Error(t, validate.Struct(destination{To: to}), to)
+	}
+}
+
+func TestE164NumIsOptIn(t *testing.T) {
+	type strictDestination struct {
+		To string `validate:"required,e164num"`
+	}
+	destinations := map[string]func(to string) interface{}{
+		"SMSDestination": func(to string) interface{} { return SMSDestination{To: to} },
+		"MsgCommon":      func(to string) interface{} { return MsgCommon{To: to} },
+		"MMSHead":        func(to string) interface{} { return MMSHead{To: to} },
+		"RCSMsg":         func(to string) interface{} { return RCSMsg{To: to} },
+	}
+
+	// Alphanumeric and formatted destinations are only rejected by fields using the tag.
+	for _, to := range []string{"InfoSMS", "+41 79 302 67 27", "(097) 766-6618"} {
+		for name, destination := range destinations {
+			require.NoError(t, validate.StructPartial(destination(to), "To"), name+" "+to)
+		}
+		assert.Error(t, validate.Struct(strictDestination{To: to}), to)
+	}
+}
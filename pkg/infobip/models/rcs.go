@@ -106,8 +106,28 @@ type SendRCSResponse struct {
 	} `json:"messages"`
 }
 
+// MessageIDs returns the IDs of the sent messages, e.g. to wait for their delivery.
+func (s SendRCSResponse) MessageIDs() []string {
+	messageIDs := make([]string, 0, len(s.Messages))
+	for _, msg := range s.Messages {
+		messageIDs = append(messageIDs, msg.MessageID)
+	}
+
+	return messageIDs
+}
+
 type SendRCSBulkResponse []SendRCSResponse
 
+// MessageIDs returns the IDs of the sent messages, e.g. to wait for their delivery.
+func (s SendRCSBulkResponse) MessageIDs() []string {
+	var messageIDs []string
+	for _, resp := range s {
+		messageIDs = append(messageIDs, resp.MessageIDs()...)
+	}
+
+	return messageIDs
+}
+
 type SendRCSBulkRequest struct {
 	Messages []RCSMsg `json:"messages" validate:"dive"`
 }
@@ -119,3 +139,30 @@ func (s *SendRCSBulkRequest) Validate() error {
 func (s *SendRCSBulkRequest) Marshal() (*bytes.Buffer, error) {
 	return marshalJSON(s)
 }
+
+type GetRCSDeliveryReportsParams struct {
+	BulkID    string
+	MessageID string
+	Limit     int
+}
+
+func (g *GetRCSDeliveryReportsParams) Validate() error {
+	return validate.Struct(g)
+}
+
+type GetRCSDeliveryReportsResponse struct {
+	Results []RCSDeliveryReport `json:"results"`
+}
+
+type RCSDeliveryReport struct {
+	BulkID       string    `json:"bulkId"`
+	MessageID    string    `json:"messageId"`
+	To           string    `json:"to"`
+	SentAt       string    `json:"sentAt"`
+	DoneAt       string    `json:"doneAt"`
+	MessageCount int       `json:"messageCount"`
+	CallbackData string    `json:"callbackData"`
+	Price        SMSPrice  `json:"price"`
+	Status       SMSStatus `json:"status"`
+	Error        SMSError  `json:"error"`
+}
@@ -99,6 +99,16 @@ type SendSMSResponse struct {
 	} `json:"messages"`
 }
 
+// MessageIDs returns the IDs of the sent messages, e.g. to wait for their delivery.
+func (s SendSMSResponse) MessageIDs() []string {
+	messageIDs := make([]string, 0, len(s.Messages))
+	for _, msg := range s.Messages {
+		messageIDs = append(messageIDs, msg.MessageID)
+	}
+
+	return messageIDs
+}
+
 type GetSMSDeliveryReportsParams struct {
 	BulkID    string
 	MessageID string
@@ -119,37 +129,41 @@ type SMSError struct {
 }
 
 type GetSMSDeliveryReportsResponse struct {
-	Results []struct {
-		BulkID       string    `json:"bulkId"`
-		CallbackData string    `json:"callbackData"`
-		DoneAt       string    `json:"doneAt"`
-		Error        SMSError  `json:"error"`
-		From         string    `json:"from"`
-		MccMnc       string    `json:"mccMnc"`
-		MessageID    string    `json:"messageId"`
-		Price        SMSPrice  `json:"price"`
-		SentAt       string    `json:"sentAt"`
-		SmsCount     int       `json:"smsCount"`
-		Status       SMSStatus `json:"status"`
-		To           string    `json:"to"`
-	} `json:"results"`
+	Results []SMSDeliveryReport `json:"results"`
+}
+
+type SMSDeliveryReport struct {
+	BulkID       string    `json:"bulkId"`
+	CallbackData string    `json:"callbackData"`
+	DoneAt       string    `json:"doneAt"`
+	Error        SMSError  `json:"error"`
+	From         string    `json:"from"`
+	MccMnc       string    `json:"mccMnc"`
+	MessageID    string    `json:"messageId"`
+	Price        SMSPrice  `json:"price"`
+	SentAt       string    `json:"sentAt"`
+	SmsCount     int       `json:"smsCount"`
+	Status       SMSStatus `json:"status"`
+	To           string    `json:"to"`
 }
 
 type GetSMSLogsResponse struct {
-	Results []struct {
-		BulkID    string    `json:"bulkId"`
-		MessageID string    `json:"messageId"`
-		To        string    `json:"to"`
-		From      string    `json:"from"`
-		Text      string    `json:"text"`
-		SentAt    string    `json:"sentAt"`
-		DoneAt    string    `json:"doneAt"`
-		SmsCount  int       `json:"smsCount"`
-		MccMnc    string    `json:"mccMnc"`
-		Price     SMSPrice  `json:"price"`
-		Status    SMSStatus `json:"status"`
-		Error     SMSError  `json:"error"`
-	} `json:"results"`
+	Results []SMSLog `json:"results"`
+}
+
+type SMSLog struct {
+	BulkID    string    `json:"bulkId"`
+	MessageID string    `json:"messageId"`
+	To        string    `json:"to"`
+	From      string    `json:"from"`
+	Text      string    `json:"text"`
+	SentAt    string    `json:"sentAt"`
+	DoneAt    string    `json:"doneAt"`
+	SmsCount  int       `json:"smsCount"`
+	MccMnc    string    `json:"mccMnc"`
+	Price     SMSPrice  `json:"price"`
+	Status    SMSStatus `json:"status"`
+	Error     SMSError  `json:"error"`
 }
 
 type GetSMSLogsParams struct {
@@ -280,16 +294,20 @@ func (r *PreviewSMSRequest) Marshal() (*bytes.Buffer, error) {
 }
 
 type PreviewSMSResponse struct {
-	OriginalText string `json:"originalText"`
-	Previews     []struct {
-		CharactersRemaining int `json:"charactersRemaining"`
-		Configuration       struct {
-			Language        SMSLanguage `json:"language"`
-			Transliteration string      `json:"transliteration"`
-		} `json:"configuration"`
-		MessageCount int    `json:"messageCount"`
-		TextPreview  string `json:"textPreview"`
-	} `json:"previews"`
+	OriginalText string       `json:"originalText"`
+	Previews     []SMSPreview `json:"previews"`
+}
+
+type SMSPreview struct {
+	CharactersRemaining int                     `json:"charactersRemaining"`
+	Configuration       SMSPreviewConfiguration `json:"configuration"`
+	MessageCount        int                     `json:"messageCount"`
+	TextPreview         string                  `json:"textPreview"`
+}
+
+type SMSPreviewConfiguration struct {
+	Language        SMSLanguage `json:"language"`
+	Transliteration string      `json:"transliteration"`
 }
 
 type GetInboundSMSParams struct {
@@ -301,20 +319,22 @@ func (g *GetInboundSMSParams) Validate() error {
 }
 
 type GetInboundSMSResponse struct {
-	MessageCount        int `json:"messageCount"`
-	PendingMessageCount int `json:"pendingMessageCount"`
-	Results             []struct {
-		CallbackData string   `json:"callbackData"`
-		CleanText    string   `json:"cleanText"`
-		From         string   `json:"from"`
-		Keyword      string   `json:"keyword"`
-		MessageID    string   `json:"messageId"`
-		Price        SMSPrice `json:"price"`
-		ReceivedAt   string   `json:"receivedAt"`
-		SmsCount     int      `json:"smsCount"`
-		Text         string   `json:"text"`
-		To           string   `json:"to"`
-	} `json:"results"`
+	MessageCount        int          `json:"messageCount"`
+	PendingMessageCount int          `json:"pendingMessageCount"`
+	Results             []InboundSMS `json:"results"`
+}
+
+type InboundSMS struct {
+	CallbackData string   `json:"callbackData"`
+	CleanText    string   `json:"cleanText"`
+	From         string   `json:"from"`
+	Keyword      string   `json:"keyword"`
+	MessageID    string   `json:"messageId"`
+	Price        SMSPrice `json:"price"`
+	ReceivedAt   string   `json:"receivedAt"`
+	SmsCount     int      `json:"smsCount"`
+	Text         string   `json:"text"`
+	To           string   `json:"to"`
 }
 
 type GetScheduledSMSParams struct {
@@ -1,8 +1,15 @@
 package models
 
 import (
+	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
 	"time"
 	"unicode"
 
@@ -10,13 +17,19 @@ import (
 	"mvdan.cc/xurls/v2"
 )
 
-const maxInteractiveListRows = 10
+const (
+	maxInteractiveListRows = 10
+	maxCopyCodeLength      = 15
+	// sniffLen is the number of bytes used by http.DetectContentType.
+	sniffLen = 512
+)
 
 func setupWhatsAppValidations() {
 	if validate == nil {
 		validate = validator.New()
 	}
 	validate.RegisterStructValidation(templateCreateValidation, TemplateCreate{})
+	validate.RegisterStructValidation(templateEditValidation, TemplateEdit{})
 	validate.RegisterStructValidation(templateCreateButtonValidation, TemplateButton{})
 	validate.RegisterStructValidation(templateMsgValidation, TemplateMsg{})
 	validate.RegisterStructValidation(templateMsgButtonValidation, TemplateMsgButton{})
@@ -25,6 +38,11 @@ func setupWhatsAppValidations() {
 	validate.RegisterStructValidation(interactiveButtonsMsgValidation, WAInteractiveButtonsMsg{})
 	validate.RegisterStructValidation(interactiveListMsgValidation, WAInteractiveListMsg{})
 	validate.RegisterStructValidation(multiproductMsgValidation, WAInteractiveMultiproductMsg{})
+	validate.RegisterStructValidation(interactiveFlowMsgValidation, WAInteractiveFlowMsg{})
+	validate.RegisterStructValidation(interactiveURLButtonMsgValidation, WAInteractiveURLButtonMsg{})
+	validate.RegisterStructValidation(interactiveLocationRequestMsgValidation, WAInteractiveLocationRequestMsg{})
+	validate.RegisterStructValidation(templateCarouselValidation, TemplateCarousel{})
+	validate.RegisterStructValidation(interactiveOrderDetailsMsgValidation, WAInteractiveOrderDetailsMsg{})
 }
 
 type BulkWAMsgResponse struct {
@@ -60,8 +78,19 @@ type CreateWATemplateResponse struct {
 	Status            string            `json:"status"`
 	Category          string            `json:"category"`
 	Structure         TemplateStructure `json:"structure"`
+	// RejectionReason explains why a template has the REJECTED status.
+	RejectionReason string `json:"rejectionReason,omitempty"`
 }
 
+// Statuses of WhatsApp templates. A template can only be used in messages when it is APPROVED.
+const (
+	TemplateStatusPending  = "PENDING"
+	TemplateStatusApproved = "APPROVED"
+	TemplateStatusRejected = "REJECTED"
+	TemplateStatusPaused   = "PAUSED"
+	TemplateStatusDisabled = "DISABLED"
+)
+
 type TemplateStructureBody struct {
 	Text string `json:"text" validate:"required"`
 }
@@ -110,8 +139,8 @@ func templateCreateValidation(sl validator.StructLevel) {
 	validateTemplateName(sl, template)
 	validateTemplateLanguage(sl, template)
 	validateTemplateCategory(sl, template)
-	validateTemplateHeader(sl, template)
-	validateTemplateButtons(sl, template)
+	validateTemplateHeader(sl, template.Structure)
+	validateTemplateButtons(sl, template.Structure)
 }
 
 func validateTemplateName(sl validator.StructLevel, template TemplateCreate) {
@@ -140,27 +169,47 @@ func validateTemplateCategory(sl validator.StructLevel, template TemplateCreate)
 	}
 }
 
-func validateTemplateHeader(sl validator.StructLevel, template TemplateCreate) {
-	header := template.Structure.Header
+func validateTemplateHeader(sl validator.StructLevel, structure TemplateStructure) {
+	header := structure.Header
 	if header != nil && header.Format == "TEXT" && header.Text == "" {
 		sl.ReportError(header.Text, "text", "Text", "missingtext", "")
 	}
 }
 
-func validateTemplateButtons(sl validator.StructLevel, template TemplateCreate) {
+func validateTemplateButtons(sl validator.StructLevel, structure TemplateStructure) {
 	types := map[string]int{"QUICK_REPLY": 0, "PHONE_NUMBER": 0, "URL": 0}
-	for _, button := range template.Structure.Buttons {
+	for _, button := range structure.Buttons {
 		types[button.Type]++
 	}
 
 	if types["QUICK_REPLY"] > 0 && (types["URL"] > 0 || types["PHONE_NUMBER"] > 0) {
-		sl.ReportError(template.Structure.Buttons, "buttons", "Buttons", "mixedquickreplyactiontypes", "")
+		sl.ReportError(structure.Buttons, "buttons", "Buttons", "mixedquickreplyactiontypes", "")
 	}
 	if types["URL"] > 1 || types["PHONE_NUMBER"] > 1 {
-		sl.ReportError(template.Structure.Buttons, "buttons", "Buttons", "multiplesameactiontypes", "")
+		sl.ReportError(structure.Buttons, "buttons", "Buttons", "multiplesameactiontypes", "")
 	}
 }
 
+// TemplateEdit is an update of an existing template. The name and language of a template cannot be changed.
+type TemplateEdit struct {
+	Category  string            `json:"category,omitempty" validate:"omitempty,oneof=MARKETING AUTHENTICATION UTILITY"`
+	Structure TemplateStructure `json:"structure" validate:"required"`
+}
+
+func (t *TemplateEdit) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *TemplateEdit) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+func templateEditValidation(sl validator.StructLevel) {
+	template, _ := sl.Current().Interface().(TemplateEdit)
+	validateTemplateHeader(sl, template.Structure)
+	validateTemplateButtons(sl, template.Structure)
+}
+
 func templateCreateButtonValidation(sl validator.StructLevel) {
 	button, _ := sl.Current().Interface().(TemplateButton)
 	switch button.Type {
@@ -200,6 +249,7 @@ func templateMsgValidation(sl validator.StructLevel) {
 	msg, _ := sl.Current().Interface().(TemplateMsg)
 	validateTemplateMsgName(sl, msg)
 	validateTemplateMsgHeader(sl, msg)
+	validateTemplateMsgCarousel(sl, msg)
 	validateTemplateButtonLength(sl, msg.Content.TemplateData)
 	validateTemplateButtonTypes(sl, msg.Content.TemplateData)
 }
@@ -251,6 +301,13 @@ func validateTemplateMsgHeader(sl validator.StructLevel, msg TemplateMsg) {
 	}
 }
 
+func validateTemplateMsgCarousel(sl validator.StructLevel, msg TemplateMsg) {
+	data := msg.Content.TemplateData
+	if data.Carousel != nil && (data.Header != nil || len(data.Buttons) > 0) {
+		sl.ReportError(data.Carousel, "carousel", "Carousel", "carouselwithheaderorbuttons", "")
+	}
+}
+
 func validateTemplateButtonLength(sl validator.StructLevel, templateData TemplateData) {
 	if len(templateData.Buttons) > 1 && templateData.Buttons[0].Type == "URL" {
 		sl.ReportError(templateData.Buttons, "buttons", "Buttons", "dynamicurlcountoverone", "")
@@ -272,6 +329,9 @@ func templateMsgButtonValidation(sl validator.StructLevel) {
 	if button.Type == "QUICK_REPLY" && len(button.Parameter) > 128 {
 		sl.ReportError(button.Parameter, "parameter", "Parameter", "parametertoolong", "")
 	}
+	if button.Type == "COPY_CODE" && len(button.Parameter) > maxCopyCodeLength {
+		sl.ReportError(button.Parameter, "parameter", "Parameter", "parametertoolong", "")
+	}
 }
 
 type TemplateMsg struct {
@@ -280,6 +340,14 @@ type TemplateMsg struct {
 	SMSFailover *SMSFailover       `json:"smsFailover,omitempty"`
 }
 
+func (t *TemplateMsg) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *TemplateMsg) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
 type TemplateMsgContent struct {
 	TemplateName string       `json:"templateName" validate:"required,lte=512"`
 	TemplateData TemplateData `json:"templateData" validate:"required"`
@@ -287,9 +355,50 @@ type TemplateMsgContent struct {
 }
 
 type TemplateData struct {
-	Body    TemplateBody        `json:"body" validate:"required"`
-	Header  *TemplateMsgHeader  `json:"header,omitempty"`
-	Buttons []TemplateMsgButton `json:"buttons,omitempty" validate:"omitempty,max=10,dive"`
+	Body     TemplateBody        `json:"body" validate:"required"`
+	Header   *TemplateMsgHeader  `json:"header,omitempty"`
+	Buttons  []TemplateMsgButton `json:"buttons,omitempty" validate:"omitempty,max=10,dive"`
+	Carousel *TemplateCarousel   `json:"carousel,omitempty" validate:"omitempty"`
+}
+
+// TemplateCarousel holds the values of the cards of a carousel template, in order. All the cards of a carousel
+// have the same buttons.
+type TemplateCarousel struct {
+	Cards []TemplateCarouselCard `json:"cards" validate:"required,min=1,max=10,dive"`
+}
+
+type TemplateCarouselCard struct {
+	Header  TemplateCarouselCardHeader `json:"header" validate:"required"`
+	Body    *TemplateBody              `json:"body,omitempty"`
+	Buttons []TemplateMsgButton        `json:"buttons,omitempty" validate:"omitempty,max=2,dive"`
+}
+
+type TemplateCarouselCardHeader struct {
+	Type     string `json:"type" validate:"required,oneof=IMAGE VIDEO"`
+	MediaURL string `json:"mediaUrl" validate:"required,url,lte=2048"`
+}
+
+func templateCarouselValidation(sl validator.StructLevel) {
+	carousel, _ := sl.Current().Interface().(TemplateCarousel)
+	if len(carousel.Cards) == 0 {
+		return
+	}
+
+	first := carousel.Cards[0]
+	for _, card := range carousel.Cards[1:] {
+		if card.Header.Type != first.Header.Type {
+			sl.ReportError(carousel.Cards, "cards", "Cards", "mixedcardheadertypes", "")
+		}
+		if len(card.Buttons) != len(first.Buttons) {
+			sl.ReportError(carousel.Cards, "cards", "Cards", "mixedcardbuttons", "")
+			continue
+		}
+		for i, button := range card.Buttons {
+			if button.Type != first.Buttons[i].Type {
+				sl.ReportError(carousel.Cards, "cards", "Cards", "mixedcardbuttons", "")
+			}
+		}
+	}
 }
 
 type TemplateBody struct {
@@ -305,8 +414,11 @@ type TemplateMsgHeader struct {
 	Longitude   *float32 `json:"longitude,omitempty" validate:"omitempty,longitude"`
 }
 
+// TemplateMsgButton is the parameter of a template button: the payload of QUICK_REPLY buttons, the URL suffix
+// of URL buttons, or the code copied by COPY_CODE buttons. The one-time password copied by the OTP button of
+// AUTHENTICATION templates is sent as the parameter of a URL button.
 type TemplateMsgButton struct {
-	Type      string `json:"type" validate:"required,oneof=QUICK_REPLY URL"`
+	Type      string `json:"type" validate:"required,oneof=QUICK_REPLY URL COPY_CODE"`
 	Parameter string `json:"parameter" validate:"required"`
 }
 
@@ -810,3 +922,533 @@ type InteractiveMultiproductSection struct {
 type InteractiveMultiproductFooter struct {
 	Text string `json:"text" validate:"required,lte=60"`
 }
+
+type WAInteractiveFlowMsg struct {
+	MsgCommon
+	Content InteractiveFlowContent `json:"content" validate:"required"`
+}
+
+func (t *WAInteractiveFlowMsg) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *WAInteractiveFlowMsg) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+func interactiveFlowMsgValidation(sl validator.StructLevel) {
+	msg, _ := sl.Current().Interface().(WAInteractiveFlowMsg)
+	validateFlowActionPayload(sl, msg)
+}
+
+func validateFlowActionPayload(sl validator.StructLevel, msg WAInteractiveFlowMsg) {
+	action := msg.Content.Action
+	switch action.FlowAction {
+	case "", "NAVIGATE":
+		if action.FlowActionPayload == nil || action.FlowActionPayload.Screen == "" {
+			sl.ReportError(action.FlowActionPayload, "flowActionPayload", "FlowActionPayload", "missingscreen", "")
+		}
+	case "DATA_EXCHANGE":
+		if action.FlowActionPayload != nil {
+			sl.ReportError(action.FlowActionPayload, "flowActionPayload", "FlowActionPayload", "unexpectedpayload", "")
+		}
+	}
+}
+
+type InteractiveFlowContent struct {
+	Body   InteractiveFlowBody    `json:"body" validate:"required"`
+	Action InteractiveFlowAction  `json:"action" validate:"required"`
+	Header *InteractiveFlowHeader `json:"header,omitempty" validate:"omitempty"`
+	Footer *InteractiveFlowFooter `json:"footer,omitempty"`
+}
+
+type InteractiveFlowBody struct {
+	Text string `json:"text" validate:"required,lte=1024"`
+}
+
+type InteractiveFlowAction struct {
+	Mode               string `json:"mode,omitempty" validate:"omitempty,oneof=DRAFT PUBLISHED"`
+	FlowMessageVersion int32  `json:"flowMessageVersion" validate:"required,min=1"`
+	FlowToken          string `json:"flowToken,omitempty"`
+	FlowID             string `json:"flowId" validate:"required"`
+	CallToActionButton string `json:"callToActionButton" validate:"required,lte=20"`
+	FlowAction         string `json:"flowAction,omitempty" validate:"omitempty,oneof=NAVIGATE DATA_EXCHANGE"`
+
+	// FlowActionPayload is required by the NAVIGATE action, which is the default one.
+	FlowActionPayload *InteractiveFlowActionPayload `json:"flowActionPayload,omitempty"`
+}
+
+// InteractiveFlowActionPayload is the first screen of a flow opened with the NAVIGATE action, along with the
+// data it is opened with. It is omitted for the DATA_EXCHANGE action, whose first screen is chosen by the
+// endpoint of the flow.
+type InteractiveFlowActionPayload struct {
+	Screen string                 `json:"screen" validate:"required"`
+	Data   map[string]interface{} `json:"data,omitempty"`
+}
+
+type InteractiveFlowHeader struct {
+	Type string `json:"type" validate:"required,oneof=TEXT"`
+	Text string `json:"text" validate:"required,lte=60"`
+}
+
+type InteractiveFlowFooter struct {
+	Text string `json:"text" validate:"required,lte=60"`
+}
+
+type WAInteractiveURLButtonMsg struct {
+	MsgCommon
+	Content InteractiveURLButtonContent `json:"content" validate:"required"`
+}
+
+func (t *WAInteractiveURLButtonMsg) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *WAInteractiveURLButtonMsg) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+func interactiveURLButtonMsgValidation(sl validator.StructLevel) {
+	msg, _ := sl.Current().Interface().(WAInteractiveURLButtonMsg)
+	validateInteractiveURLButtonHeader(sl, msg)
+	validateInteractiveURLButtonScheme(sl, msg)
+}
+
+func validateInteractiveURLButtonHeader(sl validator.StructLevel, msg WAInteractiveURLButtonMsg) {
+	header := msg.Content.Header
+	if header == nil {
+		return
+	}
+
+	switch header.Type {
+	case "TEXT":
+		if header.Text == "" {
+			sl.ReportError(header.Text, "text", "Text", "missingtext", "")
+		}
+	case "VIDEO", "IMAGE", "DOCUMENT":
+		if header.MediaURL == "" {
+			sl.ReportError(header.MediaURL, "mediaUrl", "MediaURL", "missingmediaurl", "")
+		}
+	}
+}
+
+func validateInteractiveURLButtonScheme(sl validator.StructLevel, msg WAInteractiveURLButtonMsg) {
+	url := strings.ToLower(msg.Content.Action.URL)
+	if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		sl.ReportError(msg.Content.Action.URL, "url", "URL", "nothttpurl", "")
+	}
+}
+
+type InteractiveURLButtonContent struct {
+	Body   InteractiveURLButtonBody    `json:"body" validate:"required"`
+	Action InteractiveURLButtonAction  `json:"action" validate:"required"`
+	Header *InteractiveURLButtonHeader `json:"header,omitempty" validate:"omitempty"`
+	Footer *InteractiveURLButtonFooter `json:"footer,omitempty"`
+}
+
+type InteractiveURLButtonBody struct {
+	Text string `json:"text" validate:"required,lte=1024"`
+}
+
+type InteractiveURLButtonAction struct {
+	DisplayText string `json:"displayText" validate:"required,lte=20"`
+	URL         string `json:"url" validate:"required,url,lte=2000"`
+}
+
+type InteractiveURLButtonHeader struct {
+	Type     string `json:"type" validate:"required,oneof=TEXT VIDEO IMAGE DOCUMENT"`
+	Text     string `json:"text,omitempty" validate:"lte=60"`
+	MediaURL string `json:"mediaUrl,omitempty" validate:"omitempty,url,lte=2048"`
+}
+
+type InteractiveURLButtonFooter struct {
+	Text string `json:"text" validate:"required,lte=60"`
+}
+
+type WAInteractiveLocationRequestMsg struct {
+	MsgCommon
+	Content InteractiveLocationRequestContent `json:"content" validate:"required"`
+}
+
+func (t *WAInteractiveLocationRequestMsg) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *WAInteractiveLocationRequestMsg) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+func interactiveLocationRequestMsgValidation(sl validator.StructLevel) {
+	msg, _ := sl.Current().Interface().(WAInteractiveLocationRequestMsg)
+	if strings.TrimSpace(msg.Content.Body.Text) == "" {
+		sl.ReportError(msg.Content.Body.Text, "text", "Text", "blanktext", "")
+	}
+}
+
+type InteractiveLocationRequestContent struct {
+	Body InteractiveLocationRequestBody `json:"body" validate:"required"`
+}
+
+type InteractiveLocationRequestBody struct {
+	Text string `json:"text" validate:"required,lte=1024"`
+}
+
+type WAInteractiveOrderDetailsMsg struct {
+	MsgCommon
+	Content InteractiveOrderDetailsContent `json:"content" validate:"required"`
+}
+
+func (t *WAInteractiveOrderDetailsMsg) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *WAInteractiveOrderDetailsMsg) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+func interactiveOrderDetailsMsgValidation(sl validator.StructLevel) {
+	msg, _ := sl.Current().Interface().(WAInteractiveOrderDetailsMsg)
+	validateOrderAmountOffsets(sl, msg.Content.Action)
+	validateOrderItems(sl, msg.Content.Action.Order)
+	validateOrderTotal(sl, msg.Content.Action)
+}
+
+func validateOrderAmountOffsets(sl validator.StructLevel, action InteractiveOrderDetailsAction) {
+	order := action.Order
+	amounts := []*InteractiveOrderAmount{&order.Subtotal, &order.Tax, order.Shipping, order.Discount}
+	for i := range order.Items {
+		amounts = append(amounts, &order.Items[i].Amount, order.Items[i].SaleAmount)
+	}
+
+	for _, amount := range amounts {
+		if amount != nil && amount.Offset != action.TotalAmount.Offset {
+			sl.ReportError(action.Order, "order", "Order", "mismatchedamountoffset", "")
+			return
+		}
+	}
+}
+
+func validateOrderItems(sl validator.StructLevel, order InteractiveOrder) {
+	var subtotal int64
+	for _, item := range order.Items {
+		price := item.Amount.Value
+		if item.SaleAmount != nil {
+			if item.SaleAmount.Value > item.Amount.Value {
+				sl.ReportError(item.SaleAmount, "saleAmount", "SaleAmount", "saleamountoveramount", "")
+			}
+			price = item.SaleAmount.Value
+		}
+		subtotal += price * int64(item.Quantity)
+	}
+
+	if subtotal != order.Subtotal.Value {
+		sl.ReportError(order.Subtotal, "subtotal", "Subtotal", "subtotalnotsumofitems", "")
+	}
+}
+
+func validateOrderTotal(sl validator.StructLevel, action InteractiveOrderDetailsAction) {
+	order := action.Order
+	total := order.Subtotal.Value + order.Tax.Value
+	if order.Shipping != nil {
+		total += order.Shipping.Value
+	}
+	if order.Discount != nil {
+		total -= order.Discount.Value
+	}
+
+	if total != action.TotalAmount.Value {
+		sl.ReportError(action.TotalAmount, "totalAmount", "TotalAmount", "totalnotsumoforder", "")
+	}
+}
+
+type InteractiveOrderDetailsContent struct {
+	Body   InteractiveOrderDetailsBody    `json:"body" validate:"required"`
+	Action InteractiveOrderDetailsAction  `json:"action" validate:"required"`
+	Header *InteractiveOrderDetailsHeader `json:"header,omitempty" validate:"omitempty"`
+	Footer *InteractiveOrderDetailsFooter `json:"footer,omitempty"`
+}
+
+type InteractiveOrderDetailsBody struct {
+	Text string `json:"text" validate:"required,lte=1024"`
+}
+
+type InteractiveOrderDetailsHeader struct {
+	Type     string `json:"type" validate:"required,oneof=IMAGE"`
+	MediaURL string `json:"mediaUrl" validate:"required,url,lte=2048"`
+}
+
+type InteractiveOrderDetailsFooter struct {
+	Text string `json:"text" validate:"required,lte=60"`
+}
+
+// InteractiveOrderDetailsAction is the order a user is asked to pay for. The total amount has to be equal to
+// the subtotal, plus tax and shipping, minus discount. The subtotal has to be the sum of the items, where the
+// sale amount of an item replaces its amount.
+type InteractiveOrderDetailsAction struct {
+	ReferenceID          string                 `json:"referenceId" validate:"required,lte=35"`
+	Type                 string                 `json:"type" validate:"required,oneof=DIGITAL_GOODS PHYSICAL_GOODS"`
+	PaymentConfiguration string                 `json:"paymentConfiguration" validate:"required,lte=60"`
+	Currency             string                 `json:"currency" validate:"required,iso4217"`
+	TotalAmount          InteractiveOrderAmount `json:"totalAmount" validate:"required"`
+	Order                InteractiveOrder       `json:"order" validate:"required"`
+}
+
+// InteractiveOrderAmount is an amount of money in minor units: the amount is Value divided by Offset, e.g.
+// 12.50 is {Value: 1250, Offset: 100}. All the amounts of an order have the same offset.
+type InteractiveOrderAmount struct {
+	Value  int64 `json:"value" validate:"gte=0"`
+	Offset int32 `json:"offset" validate:"required,gt=0"`
+}
+
+type InteractiveOrder struct {
+	CatalogID           string                  `json:"catalogId,omitempty"`
+	Items               []InteractiveOrderItem  `json:"items" validate:"required,min=1,max=999,dive"`
+	Subtotal            InteractiveOrderAmount  `json:"subtotal" validate:"required"`
+	Tax                 InteractiveOrderAmount  `json:"tax" validate:"required"`
+	TaxDescription      string                  `json:"taxDescription,omitempty" validate:"lte=60"`
+	Shipping            *InteractiveOrderAmount `json:"shipping,omitempty" validate:"omitempty"`
+	ShippingDescription string                  `json:"shippingDescription,omitempty" validate:"lte=60"`
+	Discount            *InteractiveOrderAmount `json:"discount,omitempty" validate:"omitempty"`
+	DiscountDescription string                  `json:"discountDescription,omitempty" validate:"lte=60"`
+}
+
+type InteractiveOrderItem struct {
+	RetailerID string                  `json:"retailerId" validate:"required"`
+	Name       string                  `json:"name" validate:"required,lte=60"`
+	Amount     InteractiveOrderAmount  `json:"amount" validate:"required"`
+	SaleAmount *InteractiveOrderAmount `json:"saleAmount,omitempty" validate:"omitempty"`
+	Quantity   int32                   `json:"quantity" validate:"required,gt=0"`
+}
+
+type WAInteractiveOrderStatusMsg struct {
+	MsgCommon
+	Content InteractiveOrderStatusContent `json:"content" validate:"required"`
+}
+
+func (t *WAInteractiveOrderStatusMsg) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *WAInteractiveOrderStatusMsg) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+type InteractiveOrderStatusContent struct {
+	Body   InteractiveOrderStatusBody    `json:"body" validate:"required"`
+	Action InteractiveOrderStatusAction  `json:"action" validate:"required"`
+	Footer *InteractiveOrderStatusFooter `json:"footer,omitempty"`
+}
+
+type InteractiveOrderStatusBody struct {
+	Text string `json:"text" validate:"required,lte=1024"`
+}
+
+// InteractiveOrderStatusAction updates the status of the order sent with the same ReferenceID.
+type InteractiveOrderStatusAction struct {
+	ReferenceID string `json:"referenceId" validate:"required,lte=35"`
+	Status      string `json:"status" validate:"required,oneof=PENDING PROCESSING PARTIALLY_SHIPPED SHIPPED COMPLETED CANCELED"` //nolint:lll
+	Description string `json:"description,omitempty" validate:"lte=120"`
+}
+
+type InteractiveOrderStatusFooter struct {
+	Text string `json:"text" validate:"required,lte=60"`
+}
+
+// WAPaymentStatus is the status of the payment of an order, e.g. PENDING, CAPTURED or FAILED.
+type WAPaymentStatus struct {
+	ReferenceID   string                 `json:"referenceId"`
+	Status        string                 `json:"status"`
+	Currency      string                 `json:"currency"`
+	TotalAmount   InteractiveOrderAmount `json:"totalAmount"`
+	TransactionID string                 `json:"transactionId"`
+	PaymentMethod string                 `json:"paymentMethod"`
+	CreatedAt     string                 `json:"createdAt"`
+	UpdatedAt     string                 `json:"updatedAt"`
+}
+
+// WAMediaUpload is a media file uploaded to a sender. Media is streamed while the request is being sent, and
+// the upload is never retried. When ContentType is empty, it is detected from the first bytes of Media.
+type WAMediaUpload struct {
+	Filename    string    `validate:"required"`
+	ContentType string    `validate:"omitempty"`
+	Media       io.Reader `validate:"required"`
+}
+
+func (t *WAMediaUpload) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *WAMediaUpload) WriteMultipart(writer *multipart.Writer) error {
+	return writeMediaPart(writer, "mediaFile", t.Filename, t.ContentType, t.Media)
+}
+
+// writeMediaPart copies media into a file part of a multipart payload. When contentType is empty, it is detected
+// from the first bytes of media.
+func writeMediaPart(writer *multipart.Writer, fieldName, filename, contentType string, media io.Reader) error {
+	if contentType == "" {
+		var err error
+		if media, contentType, err = sniffContentType(media); err != nil {
+			return err
+		}
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition",
+		fmt.Sprintf(`form-data; name="%s"; filename="%s"`, fieldName, escapeQuotes(filename)))
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, media)
+
+	return err
+}
+
+// sniffContentType detects the content type of media from its first bytes, and returns a reader yielding the whole
+// media, first bytes included.
+func sniffContentType(media io.Reader) (io.Reader, string, error) {
+	buffered := bufio.NewReaderSize(media, sniffLen)
+	head, err := buffered.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, "", err
+	}
+
+	return buffered, http.DetectContentType(head), nil
+}
+
+type UploadWAMediaResponse struct {
+	URL string `json:"url"`
+}
+
+// WAMediaMetadata describes a media file, as returned by the headers of a HEAD request.
+type WAMediaMetadata struct {
+	ContentType   string
+	ContentLength int64
+}
+
+type DeleteWAMediaRequest struct {
+	URL string `json:"url" validate:"required,url,lte=2048"`
+}
+
+func (t *DeleteWAMediaRequest) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *DeleteWAMediaRequest) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+// WAIdentity describes the identity of a WhatsApp user, which changes when the user re-installs WhatsApp or
+// switches phones. Messages to a user whose identity change has not been acknowledged are not delivered.
+type WAIdentity struct {
+	Acknowledged bool   `json:"acknowledged"`
+	Hash         string `json:"hash"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+type ConfirmWAIdentityRequest struct {
+	Hash string `json:"hash" validate:"required"`
+}
+
+func (t *ConfirmWAIdentityRequest) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *ConfirmWAIdentityRequest) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+type GetWASendersResponse struct {
+	Senders []WASender `json:"senders"`
+}
+
+// WASender is a WhatsApp sender, along with its registration status and current limits.
+type WASender struct {
+	Sender         string `json:"sender"`
+	DisplayName    string `json:"displayName"`
+	Status         string `json:"status"`
+	QualityRating  string `json:"qualityRating"`
+	MessagingLimit string `json:"messagingLimit"`
+}
+
+// WASenderQuality is the quality rating of a sender, GREEN, YELLOW or RED, and its messaging limit tier, i.e. the
+// number of users it can start conversations with per day, e.g. TIER_1K or TIER_UNLIMITED.
+type WASenderQuality struct {
+	Sender         string `json:"sender"`
+	QualityRating  string `json:"qualityRating"`
+	Status         string `json:"status"`
+	MessagingLimit string `json:"messagingLimit"`
+	LastUpdated    string `json:"lastUpdated"`
+}
+
+type WABusinessProfile struct {
+	About       string   `json:"about,omitempty" validate:"lte=139"`
+	Address     string   `json:"address,omitempty" validate:"lte=256"`
+	Description string   `json:"description,omitempty" validate:"lte=512"`
+	Email       string   `json:"email,omitempty" validate:"omitempty,email,lte=128"`
+	Websites    []string `json:"websites,omitempty" validate:"omitempty,max=2,dive,url,lte=256"`
+	Vertical    string   `json:"vertical,omitempty"`
+	PhotoURL    string   `json:"photoUrl,omitempty" validate:"omitempty,url"`
+}
+
+func (t *WABusinessProfile) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *WABusinessProfile) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+// WABusinessProfilePhoto is the profile photo of a sender, streamed while the request is being sent. When
+// ContentType is empty, it is detected from the first bytes of Photo during validation, so that photos which are
+// neither JPEG nor PNG images are rejected before being sent.
+type WABusinessProfilePhoto struct {
+	Filename    string    `validate:"required"`
+	ContentType string    `validate:"omitempty,oneof=image/jpeg image/png"`
+	Photo       io.Reader `validate:"required"`
+}
+
+func (t *WABusinessProfilePhoto) Validate() error {
+	if t.ContentType == "" && t.Photo != nil {
+		var err error
+		if t.Photo, t.ContentType, err = sniffContentType(t.Photo); err != nil {
+			return err
+		}
+	}
+
+	return validate.Struct(t)
+}
+
+func (t *WABusinessProfilePhoto) WriteMultipart(writer *multipart.Writer) error {
+	return writeMediaPart(writer, "photo", t.Filename, t.ContentType, t.Photo)
+}
+
+// RegisterWASenderRequest starts the registration of a phone number as a sender. A verification code is sent
+// to the number by SMS or voice call, which is then passed to VerifySender.
+type RegisterWASenderRequest struct {
+	PhoneNumber        string `json:"phoneNumber" validate:"required,lte=24"`
+	DisplayName        string `json:"displayName" validate:"required,lte=256"`
+	VerificationMethod string `json:"verificationMethod" validate:"required,oneof=SMS VOICE"`
+	Language           string `json:"language,omitempty"`
+}
+
+func (t *RegisterWASenderRequest) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *RegisterWASenderRequest) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
+
+type VerifyWASenderRequest struct {
+	Code string `json:"code" validate:"required,numeric,len=6"`
+}
+
+func (t *VerifyWASenderRequest) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *VerifyWASenderRequest) Marshal() (*bytes.Buffer, error) {
+	return marshalJSON(t)
+}
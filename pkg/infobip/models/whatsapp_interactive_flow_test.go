@@ -0,0 +1,132 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidInteractiveFlowMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance WAInteractiveFlowMsg
+	}{
+		{
+			name: "minimum input",
+			instance: WAInteractiveFlowMsg{
+				MsgCommon: GenerateTestMsgCommon(),
+				Content: InteractiveFlowContent{
+					Body: InteractiveFlowBody{Text: "Book your appointment"},
+					Action: InteractiveFlowAction{
+						FlowMessageVersion: 3,
+						FlowID:             "1234567890",
+						CallToActionButton: "Book",
+						FlowActionPayload:  &InteractiveFlowActionPayload{Screen: "WELCOME"},
+					},
+				},
+			},
+		},
+		{
+			name: "complete input",
+			instance: WAInteractiveFlowMsg{
+				MsgCommon: GenerateTestMsgCommon(),
+				Content: InteractiveFlowContent{
+					Body: InteractiveFlowBody{Text: "Book your appointment"},
+					Action: InteractiveFlowAction{
+						Mode:               "PUBLISHED",
+						FlowMessageVersion: 3,
+						FlowToken:          "some-token",
+						FlowID:             "1234567890",
+						CallToActionButton: "Book",
+						FlowAction:         "NAVIGATE",
+						FlowActionPayload: &InteractiveFlowActionPayload{
+							Screen: "WELCOME",
+							Data:   map[string]interface{}{"name": "Ana"},
+						},
+					},
+					Header: &InteractiveFlowHeader{Type: "TEXT", Text: "Appointments"},
+					Footer: &InteractiveFlowFooter{Text: "Footer"},
+				},
+			},
+		},
+		{
+			name: "data exchange",
+			instance: WAInteractiveFlowMsg{
+				MsgCommon: GenerateTestMsgCommon(),
+				Content: InteractiveFlowContent{
+					Body: InteractiveFlowBody{Text: "Book your appointment"},
+					Action: InteractiveFlowAction{
+						FlowMessageVersion: 3,
+						FlowID:             "1234567890",
+						CallToActionButton: "Book",
+						FlowAction:         "DATA_EXCHANGE",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.instance.Validate()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestInteractiveFlowMessageConstraints(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*InteractiveFlowContent)
+	}{
+		{name: "missing body", modify: func(c *InteractiveFlowContent) { c.Body.Text = "" }},
+		{name: "missing FlowID", modify: func(c *InteractiveFlowContent) { c.Action.FlowID = "" }},
+		{
+			name:   "missing FlowMessageVersion",
+			modify: func(c *InteractiveFlowContent) { c.Action.FlowMessageVersion = 0 },
+		},
+		{name: "invalid Mode", modify: func(c *InteractiveFlowContent) { c.Action.Mode = "LIVE" }},
+		{name: "invalid FlowAction", modify: func(c *InteractiveFlowContent) { c.Action.FlowAction = "OPEN" }},
+		{
+			name:   "CallToActionButton too long",
+			modify: func(c *InteractiveFlowContent) { c.Action.CallToActionButton = strings.Repeat("a", 21) },
+		},
+		{
+			name:   "invalid header type",
+			modify: func(c *InteractiveFlowContent) { c.Header = &InteractiveFlowHeader{Type: "IMAGE", Text: "a"} },
+		},
+		{
+			name:   "missing payload for NAVIGATE",
+			modify: func(c *InteractiveFlowContent) { c.Action.FlowActionPayload = nil },
+		},
+		{
+			name: "missing payload screen",
+			modify: func(c *InteractiveFlowContent) {
+				c.Action.FlowActionPayload = &InteractiveFlowActionPayload{}
+			},
+		},
+		{
+			name:   "payload for DATA_EXCHANGE",
+			modify: func(c *InteractiveFlowContent) { c.Action.FlowAction = "DATA_EXCHANGE" },
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			content := InteractiveFlowContent{
+				Body: InteractiveFlowBody{Text: "Book your appointment"},
+				Action: InteractiveFlowAction{
+					FlowMessageVersion: 3,
+					FlowID:             "1234567890",
+					CallToActionButton: "Book",
+					FlowActionPayload:  &InteractiveFlowActionPayload{Screen: "WELCOME"},
+				},
+			}
+			tc.modify(&content)
+			msg := WAInteractiveFlowMsg{MsgCommon: GenerateTestMsgCommon(), Content: content}
+			err := msg.Validate()
+			require.NotNil(t, err)
+		})
+	}
+}
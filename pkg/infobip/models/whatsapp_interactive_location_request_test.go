@@ -0,0 +1,41 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidInteractiveLocationRequestMessage(t *testing.T) {
+	msg := WAInteractiveLocationRequestMsg{
+		MsgCommon: GenerateTestMsgCommon(),
+		Content: InteractiveLocationRequestContent{
+			Body: InteractiveLocationRequestBody{Text: "Where should we deliver your order?"},
+		},
+	}
+
+	require.NoError(t, msg.Validate())
+}
+
+func TestInteractiveLocationRequestMessageConstraints(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+	}{
+		{name: "missing text", text: ""},
+		{name: "blank text", text: "  \n "},
+		{name: "text too long", text: strings.Repeat("a", 1025)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := WAInteractiveLocationRequestMsg{
+				MsgCommon: GenerateTestMsgCommon(),
+				Content:   InteractiveLocationRequestContent{Body: InteractiveLocationRequestBody{Text: tc.text}},
+			}
+			err := msg.Validate()
+			require.NotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func generateTestOrderDetailsMsg() WAInteractiveOrderDetailsMsg {
+	return WAInteractiveOrderDetailsMsg{
+		MsgCommon: GenerateTestMsgCommon(),
+		Content: InteractiveOrderDetailsContent{
+			Body: InteractiveOrderDetailsBody{Text: "Your order is ready for payment."},
+			Action: InteractiveOrderDetailsAction{
+				ReferenceID:          "order-42",
+				Type:                 "PHYSICAL_GOODS",
+				PaymentConfiguration: "checkout",
+				Currency:             "INR",
+				TotalAmount:          InteractiveOrderAmount{Value: 26500, Offset: 100},
+				Order: InteractiveOrder{
+					Items: []InteractiveOrderItem{
+						{
+							RetailerID: "sku-1",
+							Name:       "T-shirt",
+							Amount:     InteractiveOrderAmount{Value: 10000, Offset: 100},
+							SaleAmount: &InteractiveOrderAmount{Value: 8000, Offset: 100},
+							Quantity:   2,
+						},
+						{
+							RetailerID: "sku-2",
+							Name:       "Socks",
+							Amount:     InteractiveOrderAmount{Value: 5000, Offset: 100},
+							Quantity:   1,
+						},
+					},
+					Subtotal: InteractiveOrderAmount{Value: 21000, Offset: 100},
+					Tax:      InteractiveOrderAmount{Value: 3000, Offset: 100},
+					Shipping: &InteractiveOrderAmount{Value: 3500, Offset: 100},
+					Discount: &InteractiveOrderAmount{Value: 1000, Offset: 100},
+				},
+			},
+		},
+	}
+}
+
+func TestValidInteractiveOrderDetailsMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance func(msg *WAInteractiveOrderDetailsMsg)
+	}{
+		{name: "full order", instance: func(msg *WAInteractiveOrderDetailsMsg) {}},
+		{
+			name: "without shipping and discount",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) {
+				msg.Content.Action.Order.Shipping = nil
+				msg.Content.Action.Order.Discount = nil
+				msg.Content.Action.TotalAmount.Value = 24000
+			},
+		},
+		{
+			name: "with header and footer",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) {
+				msg.Content.Header = &InteractiveOrderDetailsHeader{Type: "IMAGE", MediaURL: "https://myurl.com/order.png"}
+				msg.Content.Footer = &InteractiveOrderDetailsFooter{Text: "Thank you!"}
+			},
+		},
+		{
+			name: "zero tax",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) {
+				msg.Content.Action.Order.Tax.Value = 0
+				msg.Content.Action.TotalAmount.Value = 23500
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := generateTestOrderDetailsMsg()
+			tc.instance(&msg)
+			require.NoError(t, msg.Validate())
+		})
+	}
+}
+
+func TestInteractiveOrderDetailsMessageConstraints(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance func(msg *WAInteractiveOrderDetailsMsg)
+	}{
+		{name: "missing body", instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Body.Text = "" }},
+		{
+			name:     "missing reference ID",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.ReferenceID = "" },
+		},
+		{
+			name: "reference ID too long",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) {
+				msg.Content.Action.ReferenceID = strings.Repeat("a", 36)
+			},
+		},
+		{name: "invalid type", instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.Type = "GOODS" }},
+		{
+			name:     "missing payment configuration",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.PaymentConfiguration = "" },
+		},
+		{name: "invalid currency", instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.Currency = "XYZ" }},
+		{name: "no items", instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.Order.Items = nil }},
+		{
+			name:     "missing item name",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.Order.Items[1].Name = "" },
+		},
+		{
+			name:     "zero quantity",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.Order.Items[1].Quantity = 0 },
+		},
+		{
+			name: "negative amount",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) {
+				msg.Content.Action.Order.Tax.Value = -500
+				msg.Content.Action.TotalAmount.Value = 23000
+			},
+		},
+		{
+			name:     "missing offset",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.TotalAmount.Offset = 0 },
+		},
+		{
+			name: "mismatched offsets",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) {
+				msg.Content.Action.Order.Shipping = &InteractiveOrderAmount{Value: 3500, Offset: 10}
+			},
+		},
+		{
+			name: "sale amount over amount",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) {
+				msg.Content.Action.Order.Items[0].SaleAmount.Value = 12000
+				msg.Content.Action.Order.Subtotal.Value = 29000
+				msg.Content.Action.TotalAmount.Value = 34500
+			},
+		},
+		{
+			name:     "subtotal is not the sum of items",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.Order.Subtotal.Value = 25000 },
+		},
+		{
+			name:     "total is not the sum of the order",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) { msg.Content.Action.TotalAmount.Value = 27500 },
+		},
+		{
+			name: "invalid header type",
+			instance: func(msg *WAInteractiveOrderDetailsMsg) {
+				msg.Content.Header = &InteractiveOrderDetailsHeader{Type: "VIDEO", MediaURL: "https://myurl.com/order.mp4"}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := generateTestOrderDetailsMsg()
+			tc.instance(&msg)
+			err := msg.Validate()
+			require.NotNil(t, err)
+		})
+	}
+}
+
+func TestValidInteractiveOrderStatusMessage(t *testing.T) {
+	msg := WAInteractiveOrderStatusMsg{
+		MsgCommon: GenerateTestMsgCommon(),
+		Content: InteractiveOrderStatusContent{
+			Body:   InteractiveOrderStatusBody{Text: "Your order has been shipped."},
+			Action: InteractiveOrderStatusAction{ReferenceID: "order-42", Status: "SHIPPED"},
+			Footer: &InteractiveOrderStatusFooter{Text: "Thank you!"},
+		},
+	}
+
+	require.NoError(t, msg.Validate())
+}
+
+func TestInteractiveOrderStatusMessageConstraints(t *testing.T) {
+	tests := []struct {
+		name   string
+		action InteractiveOrderStatusAction
+	}{
+		{name: "missing reference ID", action: InteractiveOrderStatusAction{Status: "SHIPPED"}},
+		{name: "missing status", action: InteractiveOrderStatusAction{ReferenceID: "order-42"}},
+		{name: "invalid status", action: InteractiveOrderStatusAction{ReferenceID: "order-42", Status: "LOST"}},
+		{
+			name: "description too long",
+			action: InteractiveOrderStatusAction{
+				ReferenceID: "order-42",
+				Status:      "CANCELED",
+				Description: strings.Repeat("a", 121),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := WAInteractiveOrderStatusMsg{
+				MsgCommon: GenerateTestMsgCommon(),
+				Content: InteractiveOrderStatusContent{
+					Body:   InteractiveOrderStatusBody{Text: "Your order has been updated."},
+					Action: tc.action,
+				},
+			}
+			err := msg.Validate()
+			require.NotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidInteractiveURLButtonMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance WAInteractiveURLButtonMsg
+	}{
+		{
+			name: "minimum input",
+			instance: WAInteractiveURLButtonMsg{
+				MsgCommon: GenerateTestMsgCommon(),
+				Content: InteractiveURLButtonContent{
+					Body:   InteractiveURLButtonBody{Text: "See our offer"},
+					Action: InteractiveURLButtonAction{DisplayText: "Open", URL: "https://www.example.com/offer"},
+				},
+			},
+		},
+		{
+			name: "complete input",
+			instance: WAInteractiveURLButtonMsg{
+				MsgCommon: GenerateTestMsgCommon(),
+				Content: InteractiveURLButtonContent{
+					Body:   InteractiveURLButtonBody{Text: "See our offer"},
+					Action: InteractiveURLButtonAction{DisplayText: "Open", URL: "https://www.example.com/offer"},
+					Header: &InteractiveURLButtonHeader{Type: "IMAGE", MediaURL: "https://www.example.com/image.png"},
+					Footer: &InteractiveURLButtonFooter{Text: "Footer"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.instance.Validate()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestInteractiveURLButtonMessageConstraints(t *testing.T) {
+	body := InteractiveURLButtonBody{Text: "See our offer"}
+	action := InteractiveURLButtonAction{DisplayText: "Open", URL: "https://www.example.com/offer"}
+	tests := []struct {
+		name    string
+		content InteractiveURLButtonContent
+	}{
+		{name: "missing body", content: InteractiveURLButtonContent{Action: action}},
+		{name: "missing action", content: InteractiveURLButtonContent{Body: body}},
+		{
+			name: "DisplayText too long",
+			content: InteractiveURLButtonContent{Body: body, Action: InteractiveURLButtonAction{
+				DisplayText: strings.Repeat("a", 21), URL: action.URL,
+			}},
+		},
+		{
+			name: "non-HTTP URL",
+			content: InteractiveURLButtonContent{Body: body, Action: InteractiveURLButtonAction{
+				DisplayText: "Open", URL: "ftp://www.example.com/offer",
+			}},
+		},
+		{
+			name: "missing header text",
+			content: InteractiveURLButtonContent{
+				Body: body, Action: action, Header: &InteractiveURLButtonHeader{Type: "TEXT"},
+			},
+		},
+		{
+			name: "missing header media URL",
+			content: InteractiveURLButtonContent{
+				Body: body, Action: action, Header: &InteractiveURLButtonHeader{Type: "VIDEO"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := WAInteractiveURLButtonMsg{MsgCommon: GenerateTestMsgCommon(), Content: tc.content}
+			err := msg.Validate()
+			require.NotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWAMediaUploadConstraints(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance WAMediaUpload
+	}{
+		{name: "missing Filename", instance: WAMediaUpload{Media: strings.NewReader("content")}},
+		{name: "missing Media", instance: WAMediaUpload{Filename: "image.png"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.instance.Validate()
+			require.NotNil(t, err)
+		})
+	}
+}
+
+func TestWAMediaUploadWriteMultipart(t *testing.T) {
+	tests := []struct {
+		name                string
+		instance            WAMediaUpload
+		expectedContentType string
+	}{
+		{
+			name:                "detected content type",
+			instance:            WAMediaUpload{Filename: "page.html", Media: strings.NewReader("<html></html>")},
+			expectedContentType: "text/html; charset=utf-8",
+		},
+		{
+			name: "explicit content type",
+			instance: WAMediaUpload{
+				Filename: "video.mp4", ContentType: "video/mp4", Media: strings.NewReader("<html></html>"),
+			},
+			expectedContentType: "video/mp4",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.instance.Validate())
+			var buf bytes.Buffer
+			writer := multipart.NewWriter(&buf)
+			require.NoError(t, tc.instance.WriteMultipart(writer))
+			require.NoError(t, writer.Close())
+
+			reader := multipart.NewReader(&buf, writer.Boundary())
+			part, err := reader.NextPart()
+			require.NoError(t, err)
+			assert.Equal(t, "mediaFile", part.FormName())
+			assert.Equal(t, tc.instance.Filename, part.FileName())
+			assert.Equal(t, tc.expectedContentType, part.Header.Get("Content-Type"))
+			content, err := ioutil.ReadAll(part)
+			require.NoError(t, err)
+			assert.Equal(t, "<html></html>", string(content))
+		})
+	}
+}
+
+func TestDeleteWAMediaRequestConstraints(t *testing.T) {
+	require.NoError(t, (&DeleteWAMediaRequest{URL: "https://www.mypath.com/media/1"}).Validate())
+
+	tests := []DeleteWAMediaRequest{
+		{},
+		{URL: "asd"},
+		{URL: "https://www.g" + strings.Repeat("o", 2040) + "gle.com"},
+	}
+	for _, tc := range tests {
+		require.NotNil(t, tc.Validate())
+	}
+}
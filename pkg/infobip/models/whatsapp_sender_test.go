@@ -0,0 +1,67 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidWABusinessProfile(t *testing.T) {
+	tests := []WABusinessProfile{
+		{},
+		{
+			About:       "Always open",
+			Address:     "Some street 1, Zagreb",
+			Description: "Some company",
+			Email:       "info@example.com",
+			Websites:    []string{"https://www.example.com", "https://shop.example.com"},
+			Vertical:    "RETAIL",
+		},
+	}
+	for _, tc := range tests {
+		require.NoError(t, tc.Validate())
+	}
+}
+
+func TestWABusinessProfileConstraints(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance WABusinessProfile
+	}{
+		{name: "About too long", instance: WABusinessProfile{About: strings.Repeat("a", 140)}},
+		{name: "invalid Email", instance: WABusinessProfile{Email: "info"}},
+		{name: "invalid Website", instance: WABusinessProfile{Websites: []string{"example"}}},
+		{
+			name: "too many Websites",
+			instance: WABusinessProfile{Websites: []string{
+				"https://www.example.com", "https://shop.example.com", "https://blog.example.com",
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.instance.Validate()
+			require.NotNil(t, err)
+		})
+	}
+}
+
+func TestWASenderRegistrationConstraints(t *testing.T) {
+	valid := RegisterWASenderRequest{PhoneNumber: "111111111111", DisplayName: "Some Company", VerificationMethod: "VOICE"}
+	require.NoError(t, valid.Validate())
+	require.NoError(t, (&VerifyWASenderRequest{Code: "123456"}).Validate())
+
+	invalidRegistrations := []RegisterWASenderRequest{
+		{DisplayName: "Some Company", VerificationMethod: "SMS"},
+		{PhoneNumber: "111111111111", VerificationMethod: "SMS"},
+		{PhoneNumber: "111111111111", DisplayName: "Some Company"},
+	}
+	for _, tc := range invalidRegistrations {
+		require.NotNil(t, tc.Validate())
+	}
+	for _, code := range []string{"", "12345", "1234567", "12345a"} {
+		require.NotNil(t, (&VerifyWASenderRequest{Code: code}).Validate())
+	}
+}
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func carouselCard(buttons ...TemplateMsgButton) TemplateCarouselCard {
+	return TemplateCarouselCard{
+		Header:  TemplateCarouselCardHeader{Type: "IMAGE", MediaURL: "https://www.example.com/image.png"},
+		Body:    &TemplateBody{Placeholders: []string{"20%"}},
+		Buttons: buttons,
+	}
+}
+
+func carouselMsg(data TemplateData) WATemplateMsgs {
+	return WATemplateMsgs{Messages: []TemplateMsg{{
+		MsgCommon: GenerateTestMsgCommon(),
+		Content: TemplateMsgContent{
+			TemplateName: "summer_carousel",
+			Language:     "en",
+			TemplateData: data,
+		},
+	}}}
+}
+
+func TestValidTemplateCarouselMessage(t *testing.T) {
+	quickReply := TemplateMsgButton{Type: "QUICK_REPLY", Parameter: "buy"}
+	url := TemplateMsgButton{Type: "URL", Parameter: "product-1"}
+	msgs := carouselMsg(TemplateData{
+		Body: TemplateBody{Placeholders: []string{"Ana"}},
+		Carousel: &TemplateCarousel{Cards: []TemplateCarouselCard{
+			carouselCard(quickReply, url),
+			carouselCard(quickReply, url),
+		}},
+	})
+
+	require.NoError(t, msgs.Validate())
+}
+
+func TestValidCopyCodeButton(t *testing.T) {
+	msgs := carouselMsg(TemplateData{
+		Body:    TemplateBody{Placeholders: []string{"Ana"}},
+		Buttons: []TemplateMsgButton{{Type: "COPY_CODE", Parameter: "SUMMER20"}},
+	})
+
+	require.NoError(t, msgs.Validate())
+}
+
+func TestTemplateCarouselMessageConstraints(t *testing.T) {
+	quickReply := TemplateMsgButton{Type: "QUICK_REPLY", Parameter: "buy"}
+	url := TemplateMsgButton{Type: "URL", Parameter: "product-1"}
+	videoCard := carouselCard(quickReply)
+	videoCard.Header.Type = "VIDEO"
+	tests := []struct {
+		name string
+		data TemplateData
+	}{
+		{
+			name: "no cards",
+			data: TemplateData{Carousel: &TemplateCarousel{}},
+		},
+		{
+			name: "missing card header",
+			data: TemplateData{Carousel: &TemplateCarousel{Cards: []TemplateCarouselCard{{}}}},
+		},
+		{
+			name: "different button counts",
+			data: TemplateData{Carousel: &TemplateCarousel{Cards: []TemplateCarouselCard{
+				carouselCard(quickReply, url), carouselCard(quickReply),
+			}}},
+		},
+		{
+			name: "different button types",
+			data: TemplateData{Carousel: &TemplateCarousel{Cards: []TemplateCarouselCard{
+				carouselCard(quickReply), carouselCard(url),
+			}}},
+		},
+		{
+			name: "different header types",
+			data: TemplateData{Carousel: &TemplateCarousel{Cards: []TemplateCarouselCard{
+				carouselCard(quickReply), videoCard,
+			}}},
+		},
+		{
+			name: "carousel with header",
+			data: TemplateData{
+				Header:   &TemplateMsgHeader{Type: "TEXT", Placeholder: "Hi"},
+				Carousel: &TemplateCarousel{Cards: []TemplateCarouselCard{carouselCard(quickReply)}},
+			},
+		},
+		{
+			name: "copy code too long",
+			data: TemplateData{Buttons: []TemplateMsgButton{{Type: "COPY_CODE", Parameter: "SUMMER2022DISCOUNT"}}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.data.Body = TemplateBody{Placeholders: []string{"Ana"}}
+			msgs := carouselMsg(tc.data)
+			err := msgs.Validate()
+			require.NotNil(t, err)
+		})
+	}
+}
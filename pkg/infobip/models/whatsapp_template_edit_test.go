@@ -0,0 +1,85 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidTemplateEdit(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance TemplateEdit
+	}{
+		{
+			name: "minimum input",
+			instance: TemplateEdit{
+				Structure: TemplateStructure{Body: &TemplateStructureBody{"body {{1}} content"}, Type: "TEXT"},
+			},
+		},
+		{
+			name: "complete input",
+			instance: TemplateEdit{
+				Category: "UTILITY",
+				Structure: TemplateStructure{
+					Header: &TemplateHeader{Format: "TEXT", Text: "Some text"},
+					Body:   &TemplateStructureBody{"body {{1}} content"},
+					Footer: &TemplateStructureFooter{"Footer text"},
+					Buttons: []TemplateButton{
+						{Type: "QUICK_REPLY", Text: "Yes"},
+						{Type: "QUICK_REPLY", Text: "No"},
+					},
+					Type: "TEXT",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.instance.Validate()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTemplateEditConstraints(t *testing.T) {
+	body := &TemplateStructureBody{"body {{1}} content"}
+	tests := []struct {
+		name     string
+		instance TemplateEdit
+	}{
+		{
+			name:     "missing Body",
+			instance: TemplateEdit{Structure: TemplateStructure{Type: "TEXT"}},
+		},
+		{
+			name:     "invalid Category",
+			instance: TemplateEdit{Category: "OTHER", Structure: TemplateStructure{Body: body, Type: "TEXT"}},
+		},
+		{
+			name: "missing header Text",
+			instance: TemplateEdit{Structure: TemplateStructure{
+				Header: &TemplateHeader{Format: "TEXT"}, Body: body, Type: "TEXT",
+			}},
+		},
+		{
+			name: "mixed button types",
+			instance: TemplateEdit{Structure: TemplateStructure{
+				Body: body,
+				Buttons: []TemplateButton{
+					{Type: "QUICK_REPLY", Text: "Yes"},
+					{Type: "URL", Text: "Visit", URL: "https://www.infobip.com"},
+				},
+				Type: "TEXT",
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.instance.Validate()
+			require.NotNil(t, err)
+		})
+	}
+}
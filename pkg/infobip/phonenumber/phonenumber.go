@@ -0,0 +1,158 @@
+// Package phonenumber normalizes phone numbers offline to the E.164 format expected by the API for the
+// destinations of messages, e.g. the To fields of SMS, MMS, WhatsApp and RCS messages:
+//
+//	number, err := phonenumber.Normalize("(01) 4567-890", "HR") // "+38514567890"
+//
+// Numbers written in international format, starting with "+" or with the international call prefix of the region,
+// keep their country calling code. Other numbers are national numbers of the region, whose national prefix is
+// replaced by the country calling code of the region. Normalization checks the country calling code and the length
+// of numbers, not whether they are assigned to a subscriber.
+package phonenumber
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// maxDigits is the maximum number of digits of E.164 numbers, country calling code included.
+	maxDigits            = 15
+	minNationalDigits    = 4
+	maxCallingCodeDigits = 3
+	// omittedNationalPrefix is a national prefix sometimes written after the country calling code, like in
+	// "+44 (0)20 7946 0958", which must be dialed only within the country.
+	omittedNationalPrefix = "(0)"
+	// separators are the characters ignored in numbers, including no-break spaces.
+	separators = " \u00a0-./()"
+)
+
+var (
+	ErrInvalidNumber      = errors.New("invalid phone number")
+	ErrUnknownCallingCode = errors.New("unknown country calling code")
+	ErrUnknownRegion      = errors.New("unknown region")
+)
+
+// Number is a phone number split into its country calling code and its national number.
+type Number struct {
+	// CallingCode is the country calling code, e.g. "385".
+	CallingCode string
+	// NationalNumber is the number without the country calling code and the national prefix, e.g. "977666618".
+	NationalNumber string
+}
+
+// E164 returns the number in E.164 format, e.g. "+385977666618".
+func (n Number) E164() string {
+	return "+" + n.Digits()
+}
+
+// Digits returns the number in E.164 format without the leading "+", as it is written in the examples of the API,
+// e.g. "385977666618".
+func (n Number) Digits() string {
+	return n.CallingCode + n.NationalNumber
+}
+
+// Parse parses a phone number written in international format, or in the national format of region, an
+// ISO 3166-1 alpha-2 code like "HR". Spaces, dots, dashes, slashes and parentheses are ignored. When region is
+// empty, numbers must be written in international format, where the leading "+" may be omitted like in the examples
+// of the API, or replaced by "00".
+func Parse(number, region string) (Number, error) {
+	var plan numberingPlan
+	if region != "" {
+		var ok bool
+		if plan, ok = plans[strings.ToUpper(region)]; !ok {
+			return Number{}, fmt.Errorf("%w: %s", ErrUnknownRegion, region)
+		}
+	}
+
+	digits, international, err := stripFormatting(number)
+	if err != nil {
+		return Number{}, err
+	}
+	switch {
+	case international:
+	case region == "":
+		digits = strings.TrimPrefix(digits, defaultInternationalPrefix)
+		international = true
+	case strings.HasPrefix(digits, plan.internationalPrefix):
+		digits = digits[len(plan.internationalPrefix):]
+		international = true
+	}
+
+	if international {
+		return splitCallingCode(digits)
+	}
+	if plan.nationalPrefix != "" {
+		digits = strings.TrimPrefix(digits, plan.nationalPrefix)
+	}
+	return newNumber(plan.callingCode, digits)
+}
+
+// Normalize returns a phone number in E.164 format, parsing it like Parse does.
+func Normalize(number, region string) (string, error) {
+	n, err := Parse(number, region)
+	if err != nil {
+		return "", err
+	}
+
+	return n.E164(), nil
+}
+
+// IsE164 reports whether number is a phone number in E.164 format, with or without the leading "+": digits only,
+// starting with a known country calling code.
+func IsE164(number string) bool {
+	digits := strings.TrimPrefix(number, "+")
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	_, err := splitCallingCode(digits)
+
+	return err == nil
+}
+
+// stripFormatting returns the digits of a number, and whether it starts with "+".
+func stripFormatting(number string) (digits string, international bool, err error) {
+	number = strings.TrimSpace(number)
+	if strings.HasPrefix(number, "+") {
+		number, international = number[1:], true
+	}
+	number = strings.Replace(number, omittedNationalPrefix, "", 1)
+
+	var b strings.Builder
+	for _, r := range number {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case strings.ContainsRune(separators, r):
+		default:
+			return "", false, fmt.Errorf("%w: unexpected character %q", ErrInvalidNumber, r)
+		}
+	}
+
+	return b.String(), international, nil
+}
+
+// splitCallingCode splits the digits of a number in international format. Country calling codes are a prefix code,
+// so that at most one of the first digits of a number is a known country calling code.
+func splitCallingCode(digits string) (Number, error) {
+	for n := 1; n <= maxCallingCodeDigits && n <= len(digits); n++ {
+		if callingCodes[digits[:n]] {
+			return newNumber(digits[:n], digits[n:])
+		}
+	}
+
+	return Number{}, fmt.Errorf("%w: %s", ErrUnknownCallingCode, digits)
+}
+
+func newNumber(callingCode, nationalNumber string) (Number, error) {
+	switch {
+	case len(nationalNumber) < minNationalDigits:
+		return Number{}, fmt.Errorf("%w: too short", ErrInvalidNumber)
+	case len(callingCode)+len(nationalNumber) > maxDigits:
+		return Number{}, fmt.Errorf("%w: longer than %d digits", ErrInvalidNumber, maxDigits)
+	default:
+		return Number{CallingCode: callingCode, NationalNumber: nationalNumber}, nil
+	}
+}
@@ -0,0 +1,82 @@
+package phonenumber
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		number   string
+		region   string
+		expected string
+	}{
+		{number: "+1 (555) 123-4567", expected: "+15551234567"},
+		{number: "00385 97 766 6618", expected: "+385977666618"},
+		{number: "385977666618", expected: "+385977666618"},
+		{number: "+44 (0)20 7946 0958", expected: "+442079460958"},
+		{number: "+44 20 7946.0958", expected: "+442079460958"},
+		{number: "(555) 123-4567", region: "US", expected: "+15551234567"},
+		{number: "1-555-123-4567", region: "us", expected: "+15551234567"},
+		{number: "011 41 79 302 67 27", region: "US", expected: "+41793026727"},
+		{number: "097/766-6618", region: "HR", expected: "+385977666618"},
+		{number: "00 41 79 302 67 27", region: "HR", expected: "+41793026727"},
+		{number: "06 30 123 4567", region: "HU", expected: "+36301234567"},
+		{number: "8 (912) 345-67-89", region: "RU", expected: "+79123456789"},
+		{number: "06 6982 1234", region: "IT", expected: "+390669821234"},
+		{number: "0011 1 555 123 4567", region: "AU", expected: "+15551234567"},
+		{number: "+800 1234 5678", expected: "+80012345678"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.number, func(t *testing.T) {
+			number, err := Normalize(tc.number, tc.region)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, number)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	number, err := Parse("+385 97 766 6618", "")
+	require.NoError(t, err)
+	assert.Equal(t, Number{CallingCode: "385", NationalNumber: "977666618"}, number)
+	assert.Equal(t, "385977666618", number.Digits())
+
+	number, err = Parse("20 7946 0958", "GG")
+	require.NoError(t, err)
+	assert.Equal(t, "44", number.CallingCode)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		number   string
+		region   string
+		expected error
+	}{
+		{number: "+1 555 CALL NOW", expected: ErrInvalidNumber},
+		{number: "+385 12", expected: ErrInvalidNumber},
+		{number: "+385 1234 5678 9012 3", expected: ErrInvalidNumber},
+		{number: "+", expected: ErrUnknownCallingCode},
+		{number: "+999 1234 5678", expected: ErrUnknownCallingCode},
+		{number: "097 766 6618", region: "XX", expected: ErrUnknownRegion},
+	}
+	for _, tc := range tests {
+		t.Run(tc.number, func(t *testing.T) {
+			_, err := Parse(tc.number, tc.region)
+			assert.True(t, errors.Is(err, tc.expected), err)
+		})
+	}
+}
+
+func TestIsE164(t *testing.T) {
+	assert.True(t, IsE164("+385977666618"))
+	assert.True(t, IsE164("41793026727"))
+	assert.False(t, IsE164("+1 (555) 123-4567"))
+	assert.False(t, IsE164("00385977666618"))
+	assert.False(t, IsE164("+38512"))
+	assert.False(t, IsE164("++385977666618"))
+	assert.False(t, IsE164(""))
+}
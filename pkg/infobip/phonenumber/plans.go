@@ -0,0 +1,286 @@
+package phonenumber
+
+// defaultInternationalPrefix is the international call prefix of most regions, accepted in numbers parsed without
+// a region.
+const defaultInternationalPrefix = "00"
+
+// numberingPlan describes how numbers of a region are dialed.
+type numberingPlan struct {
+	// callingCode is the country calling code of the region, which may be shared with other regions.
+	callingCode string
+	// internationalPrefix is the prefix dialed before the country calling code of international numbers. Regions
+	// with multiple prefixes, e.g. to select a carrier, use their most common one.
+	internationalPrefix string
+	// nationalPrefix is the prefix dialed before national numbers, which is not part of their E.164 form.
+	nationalPrefix string
+}
+
+// plans are the numbering plans of regions, by ISO 3166-1 alpha-2 code.
+// nolint: gochecknoglobals // read-only
+var plans = map[string]numberingPlan{
+	"AC": {callingCode: "247", internationalPrefix: "00", nationalPrefix: ""},
+	"AD": {callingCode: "376", internationalPrefix: "00", nationalPrefix: ""},
+	"AE": {callingCode: "971", internationalPrefix: "00", nationalPrefix: "0"},
+	"AF": {callingCode: "93", internationalPrefix: "00", nationalPrefix: "0"},
+	"AG": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"AI": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"AL": {callingCode: "355", internationalPrefix: "00", nationalPrefix: "0"},
+	"AM": {callingCode: "374", internationalPrefix: "00", nationalPrefix: "0"},
+	"AO": {callingCode: "244", internationalPrefix: "00", nationalPrefix: ""},
+	"AR": {callingCode: "54", internationalPrefix: "00", nationalPrefix: "0"},
+	"AS": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"AT": {callingCode: "43", internationalPrefix: "00", nationalPrefix: "0"},
+	"AU": {callingCode: "61", internationalPrefix: "0011", nationalPrefix: "0"},
+	"AW": {callingCode: "297", internationalPrefix: "00", nationalPrefix: ""},
+	"AX": {callingCode: "358", internationalPrefix: "00", nationalPrefix: "0"},
+	"AZ": {callingCode: "994", internationalPrefix: "00", nationalPrefix: "0"},
+	"BA": {callingCode: "387", internationalPrefix: "00", nationalPrefix: "0"},
+	"BB": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"BD": {callingCode: "880", internationalPrefix: "00", nationalPrefix: "0"},
+	"BE": {callingCode: "32", internationalPrefix: "00", nationalPrefix: "0"},
+	"BF": {callingCode: "226", internationalPrefix: "00", nationalPrefix: ""},
+	"BG": {callingCode: "359", internationalPrefix: "00", nationalPrefix: "0"},
+	"BH": {callingCode: "973", internationalPrefix: "00", nationalPrefix: ""},
+	"BI": {callingCode: "257", internationalPrefix: "00", nationalPrefix: ""},
+	"BJ": {callingCode: "229", internationalPrefix: "00", nationalPrefix: ""},
+	"BL": {callingCode: "590", internationalPrefix: "00", nationalPrefix: "0"},
+	"BM": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"BN": {callingCode: "673", internationalPrefix: "00", nationalPrefix: ""},
+	"BO": {callingCode: "591", internationalPrefix: "00", nationalPrefix: "0"},
+	"BQ": {callingCode: "599", internationalPrefix: "00", nationalPrefix: ""},
+	"BR": {callingCode: "55", internationalPrefix: "00", nationalPrefix: "0"},
+	"BS": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"BT": {callingCode: "975", internationalPrefix: "00", nationalPrefix: ""},
+	"BW": {callingCode: "267", internationalPrefix: "00", nationalPrefix: ""},
+	"BY": {callingCode: "375", internationalPrefix: "810", nationalPrefix: "8"},
+	"BZ": {callingCode: "501", internationalPrefix: "00", nationalPrefix: ""},
+	"CA": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"CC": {callingCode: "61", internationalPrefix: "0011", nationalPrefix: "0"},
+	"CD": {callingCode: "243", internationalPrefix: "00", nationalPrefix: "0"},
+	"CF": {callingCode: "236", internationalPrefix: "00", nationalPrefix: ""},
+	"CG": {callingCode: "242", internationalPrefix: "00", nationalPrefix: ""},
+	"CH": {callingCode: "41", internationalPrefix: "00", nationalPrefix: "0"},
+	"CI": {callingCode: "225", internationalPrefix: "00", nationalPrefix: ""},
+	"CK": {callingCode: "682", internationalPrefix: "00", nationalPrefix: ""},
+	"CL": {callingCode: "56", internationalPrefix: "00", nationalPrefix: ""},
+	"CM": {callingCode: "237", internationalPrefix: "00", nationalPrefix: ""},
+	"CN": {callingCode: "86", internationalPrefix: "00", nationalPrefix: "0"},
+	"CO": {callingCode: "57", internationalPrefix: "009", nationalPrefix: "0"},
+	"CR": {callingCode: "506", internationalPrefix: "00", nationalPrefix: ""},
+	"CU": {callingCode: "53", internationalPrefix: "119", nationalPrefix: "0"},
+	"CV": {callingCode: "238", internationalPrefix: "0", nationalPrefix: ""},
+	"CW": {callingCode: "599", internationalPrefix: "00", nationalPrefix: ""},
+	"CX": {callingCode: "61", internationalPrefix: "0011", nationalPrefix: "0"},
+	"CY": {callingCode: "357", internationalPrefix: "00", nationalPrefix: ""},
+	"CZ": {callingCode: "420", internationalPrefix: "00", nationalPrefix: ""},
+	"DE": {callingCode: "49", internationalPrefix: "00", nationalPrefix: "0"},
+	"DJ": {callingCode: "253", internationalPrefix: "00", nationalPrefix: ""},
+	"DK": {callingCode: "45", internationalPrefix: "00", nationalPrefix: ""},
+	"DM": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"DO": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"DZ": {callingCode: "213", internationalPrefix: "00", nationalPrefix: "0"},
+	"EC": {callingCode: "593", internationalPrefix: "00", nationalPrefix: "0"},
+	"EE": {callingCode: "372", internationalPrefix: "00", nationalPrefix: ""},
+	"EG": {callingCode: "20", internationalPrefix: "00", nationalPrefix: "0"},
+	"EH": {callingCode: "212", internationalPrefix: "00", nationalPrefix: "0"},
+	"ER": {callingCode: "291", internationalPrefix: "00", nationalPrefix: "0"},
+	"ES": {callingCode: "34", internationalPrefix: "00", nationalPrefix: ""},
+	"ET": {callingCode: "251", internationalPrefix: "00", nationalPrefix: "0"},
+	"FI": {callingCode: "358", internationalPrefix: "00", nationalPrefix: "0"},
+	"FJ": {callingCode: "679", internationalPrefix: "00", nationalPrefix: ""},
+	"FK": {callingCode: "500", internationalPrefix: "00", nationalPrefix: ""},
+	"FM": {callingCode: "691", internationalPrefix: "011", nationalPrefix: ""},
+	"FO": {callingCode: "298", internationalPrefix: "00", nationalPrefix: ""},
+	"FR": {callingCode: "33", internationalPrefix: "00", nationalPrefix: "0"},
+	"GA": {callingCode: "241", internationalPrefix: "00", nationalPrefix: ""},
+	"GB": {callingCode: "44", internationalPrefix: "00", nationalPrefix: "0"},
+	"GD": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"GE": {callingCode: "995", internationalPrefix: "00", nationalPrefix: "0"},
+	"GF": {callingCode: "594", internationalPrefix: "00", nationalPrefix: "0"},
+	"GG": {callingCode: "44", internationalPrefix: "00", nationalPrefix: "0"},
+	"GH": {callingCode: "233", internationalPrefix: "00", nationalPrefix: "0"},
+	"GI": {callingCode: "350", internationalPrefix: "00", nationalPrefix: ""},
+	"GL": {callingCode: "299", internationalPrefix: "00", nationalPrefix: ""},
+	"GM": {callingCode: "220", internationalPrefix: "00", nationalPrefix: ""},
+	"GN": {callingCode: "224", internationalPrefix: "00", nationalPrefix: ""},
+	"GP": {callingCode: "590", internationalPrefix: "00", nationalPrefix: "0"},
+	"GQ": {callingCode: "240", internationalPrefix: "00", nationalPrefix: ""},
+	"GR": {callingCode: "30", internationalPrefix: "00", nationalPrefix: ""},
+	"GT": {callingCode: "502", internationalPrefix: "00", nationalPrefix: ""},
+	"GU": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"GW": {callingCode: "245", internationalPrefix: "00", nationalPrefix: ""},
+	"GY": {callingCode: "592", internationalPrefix: "001", nationalPrefix: ""},
+	"HK": {callingCode: "852", internationalPrefix: "001", nationalPrefix: ""},
+	"HN": {callingCode: "504", internationalPrefix: "00", nationalPrefix: ""},
+	"HR": {callingCode: "385", internationalPrefix: "00", nationalPrefix: "0"},
+	"HT": {callingCode: "509", internationalPrefix: "00", nationalPrefix: ""},
+	"HU": {callingCode: "36", internationalPrefix: "00", nationalPrefix: "06"},
+	"ID": {callingCode: "62", internationalPrefix: "001", nationalPrefix: "0"},
+	"IE": {callingCode: "353", internationalPrefix: "00", nationalPrefix: "0"},
+	"IL": {callingCode: "972", internationalPrefix: "00", nationalPrefix: "0"},
+	"IM": {callingCode: "44", internationalPrefix: "00", nationalPrefix: "0"},
+	"IN": {callingCode: "91", internationalPrefix: "00", nationalPrefix: "0"},
+	"IO": {callingCode: "246", internationalPrefix: "00", nationalPrefix: ""},
+	"IQ": {callingCode: "964", internationalPrefix: "00", nationalPrefix: "0"},
+	"IR": {callingCode: "98", internationalPrefix: "00", nationalPrefix: "0"},
+	"IS": {callingCode: "354", internationalPrefix: "00", nationalPrefix: ""},
+	"IT": {callingCode: "39", internationalPrefix: "00", nationalPrefix: ""},
+	"JE": {callingCode: "44", internationalPrefix: "00", nationalPrefix: "0"},
+	"JM": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"JO": {callingCode: "962", internationalPrefix: "00", nationalPrefix: "0"},
+	"JP": {callingCode: "81", internationalPrefix: "010", nationalPrefix: "0"},
+	"KE": {callingCode: "254", internationalPrefix: "000", nationalPrefix: "0"},
+	"KG": {callingCode: "996", internationalPrefix: "00", nationalPrefix: "0"},
+	"KH": {callingCode: "855", internationalPrefix: "001", nationalPrefix: "0"},
+	"KI": {callingCode: "686", internationalPrefix: "00", nationalPrefix: "0"},
+	"KM": {callingCode: "269", internationalPrefix: "00", nationalPrefix: ""},
+	"KN": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"KP": {callingCode: "850", internationalPrefix: "00", nationalPrefix: "0"},
+	"KR": {callingCode: "82", internationalPrefix: "001", nationalPrefix: "0"},
+	"KW": {callingCode: "965", internationalPrefix: "00", nationalPrefix: ""},
+	"KY": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"KZ": {callingCode: "7", internationalPrefix: "810", nationalPrefix: "8"},
+	"LA": {callingCode: "856", internationalPrefix: "00", nationalPrefix: "0"},
+	"LB": {callingCode: "961", internationalPrefix: "00", nationalPrefix: "0"},
+	"LC": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"LI": {callingCode: "423", internationalPrefix: "00", nationalPrefix: ""},
+	"LK": {callingCode: "94", internationalPrefix: "00", nationalPrefix: "0"},
+	"LR": {callingCode: "231", internationalPrefix: "00", nationalPrefix: "0"},
+	"LS": {callingCode: "266", internationalPrefix: "00", nationalPrefix: ""},
+	"LT": {callingCode: "370", internationalPrefix: "00", nationalPrefix: "8"},
+	"LU": {callingCode: "352", internationalPrefix: "00", nationalPrefix: ""},
+	"LV": {callingCode: "371", internationalPrefix: "00", nationalPrefix: ""},
+	"LY": {callingCode: "218", internationalPrefix: "00", nationalPrefix: "0"},
+	"MA": {callingCode: "212", internationalPrefix: "00", nationalPrefix: "0"},
+	"MC": {callingCode: "377", internationalPrefix: "00", nationalPrefix: ""},
+	"MD": {callingCode: "373", internationalPrefix: "00", nationalPrefix: "0"},
+	"ME": {callingCode: "382", internationalPrefix: "00", nationalPrefix: "0"},
+	"MF": {callingCode: "590", internationalPrefix: "00", nationalPrefix: "0"},
+	"MG": {callingCode: "261", internationalPrefix: "00", nationalPrefix: "0"},
+	"MH": {callingCode: "692", internationalPrefix: "011", nationalPrefix: "1"},
+	"MK": {callingCode: "389", internationalPrefix: "00", nationalPrefix: "0"},
+	"ML": {callingCode: "223", internationalPrefix: "00", nationalPrefix: ""},
+	"MM": {callingCode: "95", internationalPrefix: "00", nationalPrefix: "0"},
+	"MN": {callingCode: "976", internationalPrefix: "001", nationalPrefix: "0"},
+	"MO": {callingCode: "853", internationalPrefix: "00", nationalPrefix: ""},
+	"MP": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"MQ": {callingCode: "596", internationalPrefix: "00", nationalPrefix: "0"},
+	"MR": {callingCode: "222", internationalPrefix: "00", nationalPrefix: ""},
+	"MS": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"MT": {callingCode: "356", internationalPrefix: "00", nationalPrefix: ""},
+	"MU": {callingCode: "230", internationalPrefix: "020", nationalPrefix: ""},
+	"MV": {callingCode: "960", internationalPrefix: "00", nationalPrefix: ""},
+	"MW": {callingCode: "265", internationalPrefix: "00", nationalPrefix: "0"},
+	"MX": {callingCode: "52", internationalPrefix: "00", nationalPrefix: ""},
+	"MY": {callingCode: "60", internationalPrefix: "00", nationalPrefix: "0"},
+	"MZ": {callingCode: "258", internationalPrefix: "00", nationalPrefix: ""},
+	"NA": {callingCode: "264", internationalPrefix: "00", nationalPrefix: "0"},
+	"NC": {callingCode: "687", internationalPrefix: "00", nationalPrefix: ""},
+	"NE": {callingCode: "227", internationalPrefix: "00", nationalPrefix: ""},
+	"NF": {callingCode: "672", internationalPrefix: "00", nationalPrefix: ""},
+	"NG": {callingCode: "234", internationalPrefix: "009", nationalPrefix: "0"},
+	"NI": {callingCode: "505", internationalPrefix: "00", nationalPrefix: ""},
+	"NL": {callingCode: "31", internationalPrefix: "00", nationalPrefix: "0"},
+	"NO": {callingCode: "47", internationalPrefix: "00", nationalPrefix: ""},
+	"NP": {callingCode: "977", internationalPrefix: "00", nationalPrefix: "0"},
+	"NR": {callingCode: "674", internationalPrefix: "00", nationalPrefix: ""},
+	"NU": {callingCode: "683", internationalPrefix: "00", nationalPrefix: ""},
+	"NZ": {callingCode: "64", internationalPrefix: "00", nationalPrefix: "0"},
+	"OM": {callingCode: "968", internationalPrefix: "00", nationalPrefix: ""},
+	"PA": {callingCode: "507", internationalPrefix: "00", nationalPrefix: ""},
+	"PE": {callingCode: "51", internationalPrefix: "00", nationalPrefix: "0"},
+	"PF": {callingCode: "689", internationalPrefix: "00", nationalPrefix: ""},
+	"PG": {callingCode: "675", internationalPrefix: "00", nationalPrefix: ""},
+	"PH": {callingCode: "63", internationalPrefix: "00", nationalPrefix: "0"},
+	"PK": {callingCode: "92", internationalPrefix: "00", nationalPrefix: "0"},
+	"PL": {callingCode: "48", internationalPrefix: "00", nationalPrefix: ""},
+	"PM": {callingCode: "508", internationalPrefix: "00", nationalPrefix: "0"},
+	"PR": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"PS": {callingCode: "970", internationalPrefix: "00", nationalPrefix: "0"},
+	"PT": {callingCode: "351", internationalPrefix: "00", nationalPrefix: ""},
+	"PW": {callingCode: "680", internationalPrefix: "01", nationalPrefix: ""},
+	"PY": {callingCode: "595", internationalPrefix: "00", nationalPrefix: "0"},
+	"QA": {callingCode: "974", internationalPrefix: "00", nationalPrefix: ""},
+	"RE": {callingCode: "262", internationalPrefix: "00", nationalPrefix: "0"},
+	"RO": {callingCode: "40", internationalPrefix: "00", nationalPrefix: "0"},
+	"RS": {callingCode: "381", internationalPrefix: "00", nationalPrefix: "0"},
+	"RU": {callingCode: "7", internationalPrefix: "810", nationalPrefix: "8"},
+	"RW": {callingCode: "250", internationalPrefix: "00", nationalPrefix: "0"},
+	"SA": {callingCode: "966", internationalPrefix: "00", nationalPrefix: "0"},
+	"SB": {callingCode: "677", internationalPrefix: "00", nationalPrefix: ""},
+	"SC": {callingCode: "248", internationalPrefix: "00", nationalPrefix: ""},
+	"SD": {callingCode: "249", internationalPrefix: "00", nationalPrefix: "0"},
+	"SE": {callingCode: "46", internationalPrefix: "00", nationalPrefix: "0"},
+	"SG": {callingCode: "65", internationalPrefix: "001", nationalPrefix: ""},
+	"SH": {callingCode: "290", internationalPrefix: "00", nationalPrefix: ""},
+	"SI": {callingCode: "386", internationalPrefix: "00", nationalPrefix: "0"},
+	"SJ": {callingCode: "47", internationalPrefix: "00", nationalPrefix: ""},
+	"SK": {callingCode: "421", internationalPrefix: "00", nationalPrefix: "0"},
+	"SL": {callingCode: "232", internationalPrefix: "00", nationalPrefix: "0"},
+	"SM": {callingCode: "378", internationalPrefix: "00", nationalPrefix: ""},
+	"SN": {callingCode: "221", internationalPrefix: "00", nationalPrefix: ""},
+	"SO": {callingCode: "252", internationalPrefix: "00", nationalPrefix: "0"},
+	"SR": {callingCode: "597", internationalPrefix: "00", nationalPrefix: ""},
+	"SS": {callingCode: "211", internationalPrefix: "00", nationalPrefix: "0"},
+	"ST": {callingCode: "239", internationalPrefix: "00", nationalPrefix: ""},
+	"SV": {callingCode: "503", internationalPrefix: "00", nationalPrefix: ""},
+	"SX": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"SY": {callingCode: "963", internationalPrefix: "00", nationalPrefix: "0"},
+	"SZ": {callingCode: "268", internationalPrefix: "00", nationalPrefix: ""},
+	"TA": {callingCode: "290", internationalPrefix: "00", nationalPrefix: ""},
+	"TC": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"TD": {callingCode: "235", internationalPrefix: "00", nationalPrefix: ""},
+	"TG": {callingCode: "228", internationalPrefix: "00", nationalPrefix: ""},
+	"TH": {callingCode: "66", internationalPrefix: "001", nationalPrefix: "0"},
+	"TJ": {callingCode: "992", internationalPrefix: "810", nationalPrefix: ""},
+	"TK": {callingCode: "690", internationalPrefix: "00", nationalPrefix: ""},
+	"TL": {callingCode: "670", internationalPrefix: "00", nationalPrefix: ""},
+	"TM": {callingCode: "993", internationalPrefix: "810", nationalPrefix: "8"},
+	"TN": {callingCode: "216", internationalPrefix: "00", nationalPrefix: ""},
+	"TO": {callingCode: "676", internationalPrefix: "00", nationalPrefix: ""},
+	"TR": {callingCode: "90", internationalPrefix: "00", nationalPrefix: "0"},
+	"TT": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"TV": {callingCode: "688", internationalPrefix: "00", nationalPrefix: ""},
+	"TW": {callingCode: "886", internationalPrefix: "002", nationalPrefix: "0"},
+	"TZ": {callingCode: "255", internationalPrefix: "000", nationalPrefix: "0"},
+	"UA": {callingCode: "380", internationalPrefix: "00", nationalPrefix: "0"},
+	"UG": {callingCode: "256", internationalPrefix: "000", nationalPrefix: "0"},
+	"US": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"UY": {callingCode: "598", internationalPrefix: "00", nationalPrefix: "0"},
+	"UZ": {callingCode: "998", internationalPrefix: "00", nationalPrefix: ""},
+	"VA": {callingCode: "39", internationalPrefix: "00", nationalPrefix: ""},
+	"VC": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"VE": {callingCode: "58", internationalPrefix: "00", nationalPrefix: "0"},
+	"VG": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"VI": {callingCode: "1", internationalPrefix: "011", nationalPrefix: "1"},
+	"VN": {callingCode: "84", internationalPrefix: "00", nationalPrefix: "0"},
+	"VU": {callingCode: "678", internationalPrefix: "00", nationalPrefix: ""},
+	"WF": {callingCode: "681", internationalPrefix: "00", nationalPrefix: ""},
+	"WS": {callingCode: "685", internationalPrefix: "0", nationalPrefix: ""},
+	"XK": {callingCode: "383", internationalPrefix: "00", nationalPrefix: "0"},
+	"YE": {callingCode: "967", internationalPrefix: "00", nationalPrefix: "0"},
+	"YT": {callingCode: "262", internationalPrefix: "00", nationalPrefix: "0"},
+	"ZA": {callingCode: "27", internationalPrefix: "00", nationalPrefix: "0"},
+	"ZM": {callingCode: "260", internationalPrefix: "00", nationalPrefix: "0"},
+	"ZW": {callingCode: "263", internationalPrefix: "00", nationalPrefix: "0"},
+}
+
+// nonGeographicCallingCodes are the country calling codes of global services, which belong to no region.
+// nolint: gochecknoglobals // read-only
+var nonGeographicCallingCodes = []string{"800", "808", "870", "878", "881", "882", "883", "888", "979"}
+
+// callingCodes are the country calling codes of the numbering plans and of global services.
+// nolint: gochecknoglobals // read-only
+var callingCodes = newCallingCodes()
+
+func newCallingCodes() map[string]bool {
+	codes := make(map[string]bool, len(plans)+len(nonGeographicCallingCodes))
+	for _, plan := range plans {
+		codes[plan.callingCode] = true
+	}
+	for _, code := range nonGeographicCallingCodes {
+		codes[code] = true
+	}
+
+	return codes
+}
@@ -0,0 +1,17 @@
+package infobip
+
+import "github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+
+// RateLimit limits the rate of requests whose path starts with PathPrefix, e.g. "sms/" or "whatsapp/".
+// An empty PathPrefix matches every request which is not matched by a more specific prefix.
+type RateLimit = internal.RateLimit
+
+// WithRateLimit makes the client wait before sending requests which would exceed the given limits. A single
+// token bucket per limit is shared by all the channels of the client, and requests are only limited by the
+// limit with the longest matching prefix. Waiting is interrupted when the request context is done.
+// When the server responds with 429 and a Retry-After header, the matching bucket is paused accordingly.
+func WithRateLimit(limits ...RateLimit) func(*Client) {
+	return func(c *Client) {
+		c.rateLimiter = internal.NewRateLimiter(limits...)
+	}
+}
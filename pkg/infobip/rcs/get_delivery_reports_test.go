@@ -0,0 +1,146 @@
+package rcs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDeliveryReportsValidReq(t *testing.T) {
+	rawJSONResp := []byte(`
+		{
+			"results": [
+				{
+					"bulkId": "BULK-ID-123-xyz",
+					"messageId": "MESSAGE-ID-123-xyz",
+					"to": "385977666618",
+					"sentAt": "2019-11-09T16:00:00.000+0000",
+					"doneAt": "2019-11-09T16:00:00.000+0000",
+					"messageCount": 1,
+					"callbackData": "DLR callback data",
+					"price": {
+						"pricePerMessage": 0.01,
+						"currency": "EUR"
+					},
+					"status": {
+						"groupId": 3,
+						"groupName": "DELIVERED",
+						"id": 5,
+						"name": "DELIVERED_TO_HANDSET",
+						"description": "Message delivered to handset"
+					},
+					"error": {
+						"groupId": 0,
+						"groupName": "OK",
+						"id": 0,
+						"name": "NO_ERROR",
+						"description": "No Error",
+						"permanent": false
+					}
+				}
+			]
+		}
+	`)
+
+	queryParams := models.GetRCSDeliveryReportsParams{
+		BulkID:    "BULK-ID-123-xyz",
+		MessageID: "MESSAGE-ID-123-xyz",
+		Limit:     1,
+	}
+
+	expectedParams := "bulkId=BULK-ID-123-xyz&limit=1&messageId=MESSAGE-ID-123-xyz"
+
+	var expectedResp models.GetRCSDeliveryReportsResponse
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	apiKey := "some-api-key"
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, getDeliveryReportsPath))
+		assert.Equal(t, expectedParams, r.URL.RawQuery)
+		assert.Equal(t, fmt.Sprintf("App %s", apiKey), r.Header.Get("Authorization"))
+
+		_, servErr := w.Write(rawJSONResp)
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+	rcs := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+
+	msgResp, respDetails, err := rcs.GetDeliveryReports(context.Background(), queryParams)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, msgResp)
+	assert.Equal(t, "DELIVERED", msgResp.Results[0].Status.GroupName)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestGetDeliveryReports4xx(t *testing.T) {
+	rawJSONResp := []byte(`{
+		"requestError": {
+			"serviceException": {
+				"messageId": "UNAUTHORIZED",
+				"text": "Invalid login details"
+			}
+		}
+	}`)
+	var expectedResp models.ErrorDetails
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, servErr := w.Write(rawJSONResp)
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+	rcs := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+
+	msgResp, respDetails, err := rcs.GetDeliveryReports(context.Background(), models.GetRCSDeliveryReportsParams{})
+
+	require.NoError(t, err)
+	assert.Equal(t, models.GetRCSDeliveryReportsResponse{}, msgResp)
+	assert.Equal(t, http.StatusUnauthorized, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, expectedResp, respDetails.ErrorResponse)
+}
+
+func TestWaitForDelivery(t *testing.T) {
+	polls := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, getDeliveryReportsPath))
+		assert.Equal(t, "MESSAGE-ID-123-xyz", r.URL.Query().Get("messageId"))
+
+		polls++
+		groupName := "PENDING"
+		if polls == 2 {
+			groupName = "DELIVERED"
+		}
+		resp := models.GetRCSDeliveryReportsResponse{Results: []models.RCSDeliveryReport{
+			{MessageID: "MESSAGE-ID-123-xyz", Status: models.SMSStatus{GroupName: groupName}},
+		}}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer serv.Close()
+	rcs := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+	policy := internal.PollPolicy{InitialInterval: time.Millisecond}
+
+	reports, err := WaitForDelivery(context.Background(), &rcs, []string{"MESSAGE-ID-123-xyz"}, policy)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, "DELIVERED", reports["MESSAGE-ID-123-xyz"].Status.GroupName)
+}
@@ -2,14 +2,16 @@ package rcs
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
 	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
 )
 
 const (
-	sendRCSPath     = "ott/rcs/1/message"
-	sendRCSBulkPath = "ott/rcs/1/message/bulk"
+	sendRCSPath            = "ott/rcs/1/message"
+	sendRCSBulkPath        = "ott/rcs/1/message/bulk"
+	getDeliveryReportsPath = "ott/rcs/1/reports"
 )
 
 type RCS interface {
@@ -24,6 +26,13 @@ type RCS interface {
 		ctx context.Context,
 		req models.SendRCSBulkRequest,
 	) (resp models.SendRCSBulkResponse, respDetails models.ResponseDetails, err error)
+
+	// GetDeliveryReports returns delivery reports of sent RCS messages. Each request will return a batch of delivery
+	// reports only once.
+	GetDeliveryReports(
+		ctx context.Context,
+		queryParams models.GetRCSDeliveryReportsParams,
+	) (resp models.GetRCSDeliveryReportsResponse, respDetails models.ResponseDetails, err error)
 }
 
 type Channel struct {
@@ -34,6 +43,8 @@ func (rcs *Channel) Send(
 	ctx context.Context,
 	msg models.RCSMsg,
 ) (resp models.SendRCSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "RCS.Send", sendRCSPath)
+
 	respDetails, err = rcs.ReqHandler.PostJSONReq(ctx, &msg, &resp, sendRCSPath)
 	return resp, respDetails, err
 }
@@ -42,6 +53,26 @@ func (rcs *Channel) SendBulk(
 	ctx context.Context,
 	req models.SendRCSBulkRequest,
 ) (resp models.SendRCSBulkResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "RCS.SendBulk", sendRCSBulkPath)
+
 	respDetails, err = rcs.ReqHandler.PostJSONReq(ctx, &req, &resp, sendRCSBulkPath)
 	return resp, respDetails, err
 }
+
+func (rcs *Channel) GetDeliveryReports(
+	ctx context.Context,
+	queryParams models.GetRCSDeliveryReportsParams,
+) (resp models.GetRCSDeliveryReportsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "RCS.GetDeliveryReports", getDeliveryReportsPath)
+
+	params := []internal.QueryParameter{
+		{Name: "bulkId", Value: queryParams.BulkID},
+		{Name: "messageId", Value: queryParams.MessageID},
+	}
+	if queryParams.Limit > 0 {
+		params = append(params, internal.QueryParameter{Name: "limit", Value: fmt.Sprint(queryParams.Limit)})
+	}
+
+	respDetails, err = rcs.ReqHandler.GetRequest(ctx, &resp, getDeliveryReportsPath, params)
+	return resp, respDetails, err
+}
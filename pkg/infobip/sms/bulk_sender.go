@@ -0,0 +1,172 @@
+package sms
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// DefaultBulkMaxDestinations is the number of destinations per request used by SendBulk when no positive
+// maximum is given.
+const DefaultBulkMaxDestinations = 1000
+
+const bulkIDPrefixLength = 8
+
+// BulkOptions configures how SendBulk splits a request into chunks and sends them.
+type BulkOptions struct {
+	// MaxDestinations is the maximum number of destinations of each chunk, all messages included. Values below 1
+	// are replaced by DefaultBulkMaxDestinations.
+	MaxDestinations int
+	// Workers is the number of chunks sent concurrently. Values below 1 are treated as 1.
+	Workers int
+	// RequestsPerSecond limits the rate at which chunks are sent, all workers included, on top of the rate limits
+	// configured on the client. Values below or equal to 0 disable this limit.
+	RequestsPerSecond float64
+	// Burst is the maximum number of chunks which can be sent at once. Values below 1 are treated as 1.
+	Burst int
+}
+
+// BulkResult is the result of sending a single chunk of a bulk.
+type BulkResult struct {
+	Request         models.SendSMSRequest
+	Response        models.SendSMSResponse
+	ResponseDetails models.ResponseDetails
+	Err             error
+}
+
+// Failed reports whether the chunk failed with an error or a non-2xx response.
+func (r BulkResult) Failed() bool {
+	status := r.ResponseDetails.HTTPResponse.StatusCode
+	return r.Err != nil || status < http.StatusOK || status >= http.StatusMultipleChoices
+}
+
+// BulkResponse holds the results of SendBulk, in the order of the chunks.
+type BulkResponse struct {
+	// BulkID is the prefix of the bulk IDs of the chunks.
+	BulkID  string
+	Results []BulkResult
+}
+
+// Failed returns the number of chunks which failed with an error or a non-2xx response.
+func (r BulkResponse) Failed() int {
+	failed := 0
+	for _, result := range r.Results {
+		if result.Failed() {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+// Response merges the messages of the chunks which were sent successfully, in the order of the request. The
+// destinations of failed chunks can be found in the Request of their result.
+func (r BulkResponse) Response() models.SendSMSResponse {
+	resp := models.SendSMSResponse{BulkID: r.BulkID}
+	for _, result := range r.Results {
+		if !result.Failed() {
+			resp.Messages = append(resp.Messages, result.Response.Messages...)
+		}
+	}
+
+	return resp
+}
+
+// SendBulk splits a request with many destinations into chunks of at most opts.MaxDestinations destinations, and
+// sends them concurrently. The chunks share req.BulkID, or a random ID when it is empty, as the prefix of their
+// bulk IDs, e.g. "newsletter-1", "newsletter-2", so that their delivery reports can be told apart. Other fields of
+// the request, like SendingSpeedLimit, apply to each chunk on its own. A failed chunk does not stop the others,
+// and its destinations can be retried from the Request of its result. Chunks not handed to a worker before ctx is
+// done are not sent, and their result holds the context's error.
+func (sms *Channel) SendBulk(ctx context.Context, req models.SendSMSRequest, opts BulkOptions) BulkResponse {
+	bulkID := req.BulkID
+	chunks := splitBulk(req, opts.MaxDestinations)
+	results := make([]BulkResult, len(chunks))
+	if bulkID == "" {
+		var err error
+		if bulkID, err = newBulkIDPrefix(); err != nil {
+			for i := range results {
+				results[i] = BulkResult{Request: chunks[i], Err: err}
+			}
+			return BulkResponse{Results: results}
+		}
+	}
+	for i := range chunks {
+		chunks[i].BulkID = fmt.Sprintf("%s-%d", bulkID, i+1)
+		results[i].Request = chunks[i]
+	}
+
+	limiter := internal.NewRateLimiter(internal.RateLimit{RequestsPerSecond: opts.RequestsPerSecond, Burst: opts.Burst})
+	send := func(index int) {
+		results[index] = sms.sendBulkChunk(ctx, limiter, chunks[index])
+	}
+	fail := func(index int, err error) {
+		results[index].Err = err
+	}
+
+	internal.RunBulk(ctx, len(chunks), opts.Workers, send, fail)
+	return BulkResponse{BulkID: bulkID, Results: results}
+}
+
+func (sms *Channel) sendBulkChunk(
+	ctx context.Context,
+	limiter *internal.RateLimiter,
+	req models.SendSMSRequest,
+) (result BulkResult) {
+	result.Request = req
+	if result.Err = limiter.Wait(ctx, sendSMSPath); result.Err != nil {
+		return result
+	}
+
+	result.Response, result.ResponseDetails, result.Err = sms.Send(ctx, req)
+	return result
+}
+
+// splitBulk splits the destinations of the messages of a request into requests of at most maxDestinations
+// destinations, keeping their order. Messages without destinations are kept, so that they fail validation like
+// requests without messages.
+func splitBulk(req models.SendSMSRequest, maxDestinations int) []models.SendSMSRequest {
+	if maxDestinations < 1 {
+		maxDestinations = DefaultBulkMaxDestinations
+	}
+
+	var chunks []models.SendSMSRequest
+	chunk, size := req, 0
+	chunk.Messages = nil
+	for _, msg := range req.Messages {
+		destinations := msg.Destinations
+		for first := true; first || len(destinations) > 0; first = false {
+			if size == maxDestinations {
+				chunks = append(chunks, chunk)
+				chunk.Messages, size = nil, 0
+			}
+			n := len(destinations)
+			if n > maxDestinations-size {
+				n = maxDestinations - size
+			}
+			part := msg
+			part.Destinations = destinations[:n]
+			chunk.Messages = append(chunk.Messages, part)
+			destinations, size = destinations[n:], size+n
+		}
+	}
+	if len(chunk.Messages) > 0 || len(chunks) == 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+func newBulkIDPrefix() (string, error) {
+	id := make([]byte, bulkIDPrefixLength)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,125 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bulkDestinations(first, count int) []models.SMSDestination {
+	destinations := make([]models.SMSDestination, count)
+	for i := range destinations {
+		destinations[i].To = fmt.Sprint(41793026700 + first + i)
+	}
+
+	return destinations
+}
+
+func TestSplitBulk(t *testing.T) {
+	req := models.SendSMSRequest{
+		BulkID: "newsletter",
+		Messages: []models.SMSMsg{
+			{Text: "one", Destinations: bulkDestinations(0, 3)},
+			{Text: "two", Destinations: bulkDestinations(3, 4)},
+		},
+		SendingSpeedLimit: &models.SMSSendingSpeedLimit{Amount: 10, TimeUnit: "MINUTE"},
+	}
+
+	chunks := splitBulk(req, 3)
+	require.Len(t, chunks, 3)
+	assert.Equal(t, []models.SMSMsg{{Text: "one", Destinations: bulkDestinations(0, 3)}}, chunks[0].Messages)
+	assert.Equal(t, []models.SMSMsg{{Text: "two", Destinations: bulkDestinations(3, 3)}}, chunks[1].Messages)
+	assert.Equal(t, []models.SMSMsg{{Text: "two", Destinations: bulkDestinations(6, 1)}}, chunks[2].Messages)
+	assert.Equal(t, req.SendingSpeedLimit, chunks[2].SendingSpeedLimit)
+
+	chunks = splitBulk(req, 5)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, []models.SMSMsg{
+		{Text: "one", Destinations: bulkDestinations(0, 3)},
+		{Text: "two", Destinations: bulkDestinations(3, 2)},
+	}, chunks[0].Messages)
+
+	chunks = splitBulk(req, 0)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, req.Messages, chunks[0].Messages)
+
+	// Requests without messages are sent as they are, to fail validation.
+	assert.Len(t, splitBulk(models.SendSMSRequest{}, 0), 1)
+}
+
+func TestSendBulk(t *testing.T) {
+	var mu sync.Mutex
+	var bulkIDs []string
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+sendSMSPath, r.URL.Path)
+		var req models.SendSMSRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		mu.Lock()
+		bulkIDs = append(bulkIDs, req.BulkID)
+		mu.Unlock()
+
+		if req.BulkID == "newsletter-2" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte(`{"requestError": {"serviceException": {"messageId": "BAD_REQUEST"}}}`))
+			assert.NoError(t, err)
+			return
+		}
+		resp := map[string]interface{}{"bulkId": req.BulkID}
+		var messages []map[string]string
+		for _, msg := range req.Messages {
+			for _, destination := range msg.Destinations {
+				messageID := "id-" + destination.To
+				messages = append(messages, map[string]string{"to": destination.To, "messageId": messageID})
+			}
+		}
+		resp["messages"] = messages
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer serv.Close()
+
+	req := models.SendSMSRequest{
+		BulkID:   "newsletter",
+		Messages: []models.SMSMsg{{Text: "hello", Destinations: bulkDestinations(0, 5)}},
+	}
+	opts := BulkOptions{MaxDestinations: 2, Workers: 2}
+	resp := newIteratorTestChannel(serv).SendBulk(context.Background(), req, opts)
+
+	assert.ElementsMatch(t, []string{"newsletter-1", "newsletter-2", "newsletter-3"}, bulkIDs)
+	assert.Equal(t, "newsletter", resp.BulkID)
+	require.Len(t, resp.Results, 3)
+	assert.Equal(t, 1, resp.Failed())
+	assert.True(t, resp.Results[1].Failed())
+	serviceException := resp.Results[1].ResponseDetails.ErrorResponse.RequestError.ServiceException
+	assert.Equal(t, "BAD_REQUEST", serviceException.MessageID)
+	assert.Equal(t, bulkDestinations(2, 2), resp.Results[1].Request.Messages[0].Destinations)
+
+	merged := resp.Response()
+	assert.Equal(t, "newsletter", merged.BulkID)
+	var messageIDs []string
+	for _, msg := range merged.Messages {
+		messageIDs = append(messageIDs, msg.MessageID)
+	}
+	assert.Equal(t, []string{"id-41793026700", "id-41793026701", "id-41793026704"}, messageIDs)
+}
+
+func TestSendBulkCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := models.SendSMSRequest{Messages: []models.SMSMsg{{Text: "hello", Destinations: bulkDestinations(0, 3)}}}
+
+	resp := (&Channel{}).SendBulk(ctx, req, BulkOptions{MaxDestinations: 1})
+	require.Len(t, resp.Results, 3)
+	assert.Len(t, resp.BulkID, 2*bulkIDPrefixLength)
+	assert.Equal(t, resp.BulkID+"-3", resp.Results[2].Request.BulkID)
+	for _, result := range resp.Results {
+		assert.Equal(t, context.Canceled, result.Err)
+	}
+}
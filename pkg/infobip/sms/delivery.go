@@ -0,0 +1,45 @@
+package sms
+
+import (
+	"context"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// WaitForDelivery polls the SMS delivery reports of the given messages with the backoff of policy, until each of them
+// reaches a final status group: DELIVERED, UNDELIVERABLE, EXPIRED or REJECTED. The message IDs are usually taken from
+// the response to sending the messages:
+//
+//	reports, err := sms.WaitForDelivery(ctx, client.SMS, resp.MessageIDs(), infobip.DefaultPollPolicy())
+//
+// The last reports received are returned by message ID, also when ctx expires before all the messages reach a final
+// status, in which case the context's error is returned too. Unsuccessful responses stop the polling with an
+// *infobip.APIError.
+func WaitForDelivery(
+	ctx context.Context,
+	sms SMS,
+	messageIDs []string,
+	policy internal.PollPolicy,
+) (map[string]models.SMSDeliveryReport, error) {
+	reports := make(map[string]models.SMSDeliveryReport, len(messageIDs))
+	poll := func(ctx context.Context, messageID string) (bool, error) {
+		params := models.GetSMSDeliveryReportsParams{MessageID: messageID}
+		resp, respDetails, err := sms.GetDeliveryReports(ctx, params)
+		if err == nil {
+			err = internal.ResponseError(respDetails)
+		}
+		if err != nil {
+			return false, err
+		}
+
+		for _, report := range resp.Results {
+			if report.MessageID == messageID {
+				reports[messageID] = report
+			}
+		}
+		return internal.IsFinalStatus(reports[messageID].Status.GroupName), nil
+	}
+
+	return reports, internal.WaitForDelivery(ctx, messageIDs, policy, poll)
+}
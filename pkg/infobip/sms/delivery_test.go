@@ -0,0 +1,70 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForDelivery(t *testing.T) {
+	// The status groups reported on each poll of a message, where an empty group means no report.
+	statuses := map[string][]string{
+		"1": {"", "DELIVERED"},
+		"2": {"PENDING", "PENDING", "REJECTED"},
+		"3": {},
+	}
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+getDeliveryReportsPath, r.URL.Path)
+		messageID := r.URL.Query().Get("messageId")
+
+		resp := models.GetSMSDeliveryReportsResponse{Results: []models.SMSDeliveryReport{}}
+		if groups := statuses[messageID]; len(groups) > 0 {
+			if groups[0] != "" {
+				report := models.SMSDeliveryReport{MessageID: messageID, Status: models.SMSStatus{GroupName: groups[0]}}
+				resp.Results = append(resp.Results, report)
+			}
+			statuses[messageID] = groups[1:]
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer serv.Close()
+	channel := newIteratorTestChannel(serv)
+	policy := internal.PollPolicy{InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond, Multiplier: 2}
+
+	reports, err := WaitForDelivery(context.Background(), channel, []string{"1", "2"}, policy)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, "DELIVERED", reports["1"].Status.GroupName)
+	assert.Equal(t, "REJECTED", reports["2"].Status.GroupName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	statuses["1"] = []string{"DELIVERED"}
+	reports, err = WaitForDelivery(ctx, channel, []string{"1", "3"}, policy)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	// The reports received before the context expired are returned.
+	assert.Equal(t, "DELIVERED", reports["1"].Status.GroupName)
+	assert.NotContains(t, reports, "3")
+}
+
+func TestWaitForDeliveryError(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, err := w.Write([]byte(`{"requestError": {"serviceException": {"messageId": "UNAUTHORIZED"}}}`))
+		assert.NoError(t, err)
+	}))
+	defer serv.Close()
+
+	_, err := WaitForDelivery(context.Background(), newIteratorTestChannel(serv), []string{"1"}, internal.PollPolicy{})
+	var apiErr *internal.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
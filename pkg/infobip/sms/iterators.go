@@ -0,0 +1,179 @@
+package sms
+
+import (
+	"context"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// LogIterator iterates over the logs of sent messages, fetching pages of at most params.Limit logs and narrowing
+// the sentSince/sentUntil window after each page, so that all the logs matching params are returned:
+//
+//	it := sms.NewLogIterator(ctx, client.SMS, models.GetSMSLogsParams{SentSince: since, Limit: 1000}, 0)
+//	for it.Next() {
+//		export(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// Unsuccessful responses end the iteration with an *infobip.APIError.
+type LogIterator struct {
+	pager *internal.Pager
+	page  []models.SMSLog
+}
+
+// NewLogIterator creates a LogIterator returning at most maxResults logs, or all of them when maxResults is not
+// positive.
+func NewLogIterator(ctx context.Context, sms SMS, params models.GetSMSLogsParams, maxResults int) *LogIterator {
+	it := &LogIterator{}
+	window := internal.LogWindow{SentSince: params.SentSince, SentUntil: params.SentUntil}
+	it.pager = internal.NewPager(ctx, maxResults, func(ctx context.Context, _ int) (int, bool, error) {
+		params.SentSince, params.SentUntil = window.SentSince, window.SentUntil
+		resp, respDetails, err := sms.GetLogs(ctx, params)
+		if err == nil {
+			err = internal.ResponseError(respDetails)
+		}
+		if err != nil {
+			return 0, false, err
+		}
+
+		sentAt := make([]string, len(resp.Results))
+		messageIDs := make([]string, len(resp.Results))
+		for i, log := range resp.Results {
+			sentAt[i], messageIDs[i] = log.SentAt, log.MessageID
+		}
+		fresh, more, err := window.Advance(sentAt, messageIDs)
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = it.page[:0]
+		for i, log := range resp.Results {
+			if fresh[i] {
+				it.page = append(it.page, log)
+			}
+		}
+
+		return len(it.page), more && internal.FullPage(len(resp.Results), params.Limit), nil
+	})
+
+	return it
+}
+
+// Next advances to the next log, fetching the next page when needed. It returns false once the iteration is over.
+func (it *LogIterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Value returns the current log.
+func (it *LogIterator) Value() models.SMSLog {
+	return it.page[it.pager.Index()]
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *LogIterator) Err() error {
+	return it.pager.Err()
+}
+
+// DeliveryReportIterator iterates over delivery reports, fetching pages of at most params.Limit reports until no
+// more are returned. As reports are returned only once, the reports it returns are not returned by
+// GetDeliveryReports afterwards, and no more reports than the maximum of the iteration are fetched.
+type DeliveryReportIterator struct {
+	pager *internal.Pager
+	page  []models.SMSDeliveryReport
+}
+
+// NewDeliveryReportIterator creates a DeliveryReportIterator returning at most maxResults reports, or all of them
+// when maxResults is not positive.
+func NewDeliveryReportIterator(
+	ctx context.Context,
+	sms SMS,
+	params models.GetSMSDeliveryReportsParams,
+	maxResults int,
+) *DeliveryReportIterator {
+	it := &DeliveryReportIterator{}
+	limit := params.Limit
+	it.pager = internal.NewPager(ctx, maxResults, func(ctx context.Context, remaining int) (int, bool, error) {
+		params.Limit = internal.PageLimit(limit, remaining)
+		resp, respDetails, err := sms.GetDeliveryReports(ctx, params)
+		if err == nil {
+			err = internal.ResponseError(respDetails)
+		}
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = resp.Results
+
+		return len(it.page), internal.FullPage(len(it.page), params.Limit), nil
+	})
+
+	return it
+}
+
+// Next advances to the next report, fetching the next page when needed. It returns false once the iteration is
+// over.
+func (it *DeliveryReportIterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Value returns the current report.
+func (it *DeliveryReportIterator) Value() models.SMSDeliveryReport {
+	return it.page[it.pager.Index()]
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *DeliveryReportIterator) Err() error {
+	return it.pager.Err()
+}
+
+// InboundMessageIterator iterates over inbound messages, fetching pages of at most params.Limit messages until no
+// more are returned. As inbound messages are returned only once, the messages it returns are not returned by
+// GetInboundMessages afterwards, and no more messages than the maximum of the iteration are fetched.
+type InboundMessageIterator struct {
+	pager *internal.Pager
+	page  []models.InboundSMS
+}
+
+// NewInboundMessageIterator creates an InboundMessageIterator returning at most maxResults messages, or all of them
+// when maxResults is not positive.
+func NewInboundMessageIterator(
+	ctx context.Context,
+	sms SMS,
+	params models.GetInboundSMSParams,
+	maxResults int,
+) *InboundMessageIterator {
+	it := &InboundMessageIterator{}
+	limit := params.Limit
+	it.pager = internal.NewPager(ctx, maxResults, func(ctx context.Context, remaining int) (int, bool, error) {
+		params.Limit = internal.PageLimit(limit, remaining)
+		resp, respDetails, err := sms.GetInboundMessages(ctx, params)
+		if err == nil {
+			err = internal.ResponseError(respDetails)
+		}
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = resp.Results
+
+		return len(it.page), internal.FullPage(len(it.page), params.Limit), nil
+	})
+
+	return it
+}
+
+// Next advances to the next message, fetching the next page when needed. It returns false once the iteration is
+// over.
+func (it *InboundMessageIterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Value returns the current message.
+func (it *InboundMessageIterator) Value() models.InboundSMS {
+	return it.page[it.pager.Index()]
+}
+
+// Err returns the error which ended the iteration, if any.
+func (it *InboundMessageIterator) Err() error {
+	return it.pager.Err()
+}
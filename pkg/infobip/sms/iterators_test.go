@@ -0,0 +1,152 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIteratorTestChannel(serv *httptest.Server) *Channel {
+	return &Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     "secret",
+	}}
+}
+
+func TestLogIterator(t *testing.T) {
+	start := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	var logs []models.SMSLog
+	for i := 0; i < 7; i++ {
+		// Two logs are sent in every millisecond.
+		sentAt := start.Add(time.Duration(i/2) * time.Millisecond).Format(internal.LogTimeLayout)
+		logs = append(logs, models.SMSLog{MessageID: fmt.Sprint("message-", i), SentAt: sentAt})
+	}
+
+	var queries []string
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+getLogsPath, r.URL.Path)
+		query := r.URL.Query()
+		queries = append(queries, query.Get("sentSince"))
+		limit, err := strconv.Atoi(query.Get("limit"))
+		require.NoError(t, err)
+
+		resp := models.GetSMSLogsResponse{Results: []models.SMSLog{}}
+		for _, log := range logs {
+			if len(resp.Results) < limit && log.SentAt >= query.Get("sentSince") {
+				resp.Results = append(resp.Results, log)
+			}
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer serv.Close()
+
+	params := models.GetSMSLogsParams{SentSince: start.Format(internal.LogTimeLayout), Limit: 3}
+	it := NewLogIterator(context.Background(), newIteratorTestChannel(serv), params, 0)
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Value().MessageID)
+	}
+
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{
+		"message-0", "message-1", "message-2", "message-3", "message-4", "message-5", "message-6",
+	}, ids)
+	assert.Equal(t, []string{
+		params.SentSince, logs[2].SentAt, logs[4].SentAt, logs[6].SentAt,
+	}, queries)
+
+	it = NewLogIterator(context.Background(), newIteratorTestChannel(serv), params, 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, 4, count)
+}
+
+func TestDeliveryReportIterator(t *testing.T) {
+	pending := 5
+	var limits []string
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+getDeliveryReportsPath, r.URL.Path)
+		assert.Equal(t, "bulk", r.URL.Query().Get("bulkId"))
+		limits = append(limits, r.URL.Query().Get("limit"))
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		require.NoError(t, err)
+
+		resp := models.GetSMSDeliveryReportsResponse{Results: []models.SMSDeliveryReport{}}
+		for ; pending > 0 && len(resp.Results) < limit; pending-- {
+			resp.Results = append(resp.Results, models.SMSDeliveryReport{MessageID: fmt.Sprint("message-", pending)})
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer serv.Close()
+	channel := newIteratorTestChannel(serv)
+
+	it := NewDeliveryReportIterator(context.Background(), channel,
+		models.GetSMSDeliveryReportsParams{BulkID: "bulk", Limit: 2}, 3)
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Value().MessageID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"message-5", "message-4", "message-3"}, ids)
+	// The last page is limited to the remaining report, so that no report is fetched and dropped.
+	assert.Equal(t, []string{"2", "1"}, limits)
+	assert.Equal(t, 2, pending)
+
+	it = NewDeliveryReportIterator(context.Background(), channel,
+		models.GetSMSDeliveryReportsParams{BulkID: "bulk", Limit: 2}, 0)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{"2", "1", "2", "2"}, limits)
+}
+
+func TestInboundMessageIteratorError(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+getInboundSMSPath, r.URL.Path)
+		w.WriteHeader(http.StatusUnauthorized)
+		_, err := w.Write([]byte(`{"requestError": {"serviceException": {"messageId": "UNAUTHORIZED"}}}`))
+		assert.NoError(t, err)
+	}))
+	defer serv.Close()
+
+	it := NewInboundMessageIterator(context.Background(), newIteratorTestChannel(serv), models.GetInboundSMSParams{}, 0)
+	assert.False(t, it.Next())
+	var apiErr *internal.APIError
+	require.True(t, errors.As(it.Err(), &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, "UNAUTHORIZED", apiErr.MessageID)
+}
+
+func TestInboundMessageIteratorCanceled(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"results": [{"messageId": "1"}, {"messageId": "2"}]}`))
+		assert.NoError(t, err)
+	}))
+	defer serv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := NewInboundMessageIterator(ctx, newIteratorTestChannel(serv), models.GetInboundSMSParams{}, 0)
+	require.True(t, it.Next())
+	require.True(t, it.Next())
+	cancel()
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+}
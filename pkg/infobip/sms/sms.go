@@ -42,6 +42,10 @@ type SMS interface {
 	Send(ctx context.Context, req models.SendSMSRequest) (
 		resp models.SendSMSResponse, respDetails models.ResponseDetails, err error)
 
+	// SendBulk splits a request with more destinations than a single API request should carry into multiple
+	// requests sharing a bulk ID prefix, sends them concurrently and returns the results of every chunk.
+	SendBulk(ctx context.Context, req models.SendSMSRequest, opts BulkOptions) BulkResponse
+
 	// SendBinary sends single or multiple binary messages to one or more destination addresses.
 	SendBinary(ctx context.Context, req models.SendBinarySMSRequest) (
 		resp models.SendBinarySMSResponse, respDetails models.ResponseDetails, err error)
@@ -198,6 +202,8 @@ func (sms *Channel) Send(
 	ctx context.Context,
 	req models.SendSMSRequest,
 ) (resp models.SendSMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.Send", sendSMSPath)
+
 	respDetails, err = sms.ReqHandler.PostJSONReq(ctx, &req, &resp, sendSMSPath)
 	return resp, respDetails, err
 }
@@ -206,6 +212,8 @@ func (sms *Channel) SendBinary(
 	ctx context.Context,
 	req models.SendBinarySMSRequest,
 ) (resp models.SendBinarySMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.SendBinary", sendBinarySMSPath)
+
 	respDetails, err = sms.ReqHandler.PostJSONReq(ctx, &req, &resp, sendBinarySMSPath)
 	return resp, respDetails, err
 }
@@ -214,6 +222,8 @@ func (sms *Channel) GetDeliveryReports(
 	ctx context.Context,
 	queryParams models.GetSMSDeliveryReportsParams) (
 	resp models.GetSMSDeliveryReportsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetDeliveryReports", getDeliveryReportsPath)
+
 	params := []internal.QueryParameter{
 		{Name: "bulkId", Value: queryParams.BulkID},
 		{Name: "messageId", Value: queryParams.MessageID},
@@ -229,6 +239,8 @@ func (sms *Channel) GetLogs(
 	ctx context.Context,
 	queryParams models.GetSMSLogsParams,
 ) (resp models.GetSMSLogsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetLogs", getLogsPath)
+
 	params := []internal.QueryParameter{
 		{Name: "from", Value: queryParams.From},
 		{Name: "to", Value: queryParams.To},
@@ -258,6 +270,8 @@ func (sms *Channel) SendOverQueryParams(
 	ctx context.Context,
 	queryParams models.SendSMSOverQueryParamsParams,
 ) (resp models.SendSMSOverQueryParamsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.SendOverQueryParams", sendSMSOverQueryParamsPath)
+
 	params := []internal.QueryParameter{
 		{Name: "username", Value: queryParams.Username},
 		{Name: "password", Value: queryParams.Password},
@@ -292,6 +306,8 @@ func (sms *Channel) Preview(
 	ctx context.Context,
 	req models.PreviewSMSRequest,
 ) (resp models.PreviewSMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.Preview", previewSMSPath)
+
 	respDetails, err = sms.ReqHandler.PostJSONReq(ctx, &req, &resp, previewSMSPath)
 
 	return resp, respDetails, err
@@ -301,6 +317,8 @@ func (sms *Channel) GetInboundMessages(
 	ctx context.Context,
 	queryParams models.GetInboundSMSParams,
 ) (resp models.GetInboundSMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetInboundMessages", getInboundSMSPath)
+
 	var params []internal.QueryParameter
 	if queryParams.Limit > 0 {
 		params = append(params, internal.QueryParameter{Name: "limit", Value: fmt.Sprint(queryParams.Limit)})
@@ -315,6 +333,8 @@ func (sms *Channel) GetScheduledMessages(
 	ctx context.Context,
 	queryParams models.GetScheduledSMSParams,
 ) (resp models.GetScheduledSMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetScheduledMessages", getScheduledSMSPath)
+
 	params := []internal.QueryParameter{
 		{Name: "bulkId", Value: queryParams.BulkID},
 	}
@@ -329,6 +349,8 @@ func (sms *Channel) RescheduleMessages(
 	req models.RescheduleSMSRequest,
 	queryParams models.RescheduleSMSParams,
 ) (resp models.RescheduleSMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.RescheduleMessages", rescheduleSMSPath)
+
 	params := []internal.QueryParameter{
 		{Name: "bulkId", Value: queryParams.BulkID},
 	}
@@ -342,6 +364,8 @@ func (sms *Channel) GetScheduledMessagesStatus(
 	ctx context.Context,
 	queryParams models.GetScheduledSMSStatusParams,
 ) (resp models.GetScheduledSMSStatusResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetScheduledMessagesStatus", getScheduledSMSStatusPath)
+
 	params := []internal.QueryParameter{
 		{Name: "bulkId", Value: queryParams.BulkID},
 	}
@@ -356,6 +380,8 @@ func (sms *Channel) UpdateScheduledMessagesStatus(
 	req models.UpdateScheduledSMSStatusRequest,
 	queryParams models.UpdateScheduledSMSStatusParams,
 ) (resp models.UpdateScheduledSMSStatusResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.UpdateScheduledMessagesStatus", updateScheduledSMSStatusPath)
+
 	params := []internal.QueryParameter{
 		{Name: "bulkId", Value: queryParams.BulkID},
 	}
@@ -368,6 +394,8 @@ func (sms *Channel) UpdateScheduledMessagesStatus(
 func (sms *Channel) GetTFAApplications(
 	ctx context.Context,
 ) (resp models.GetTFAApplicationsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetTFAApplications", getTFAApplicationsPath)
+
 	respDetails, err = sms.ReqHandler.GetRequest(ctx, &resp, getTFAApplicationsPath, nil)
 
 	return resp, respDetails, err
@@ -377,6 +405,8 @@ func (sms *Channel) CreateTFAApplication(
 	ctx context.Context,
 	req models.CreateTFAApplicationRequest,
 ) (resp models.CreateTFAApplicationResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.CreateTFAApplication", createTFAApplicationPath)
+
 	respDetails, err = sms.ReqHandler.PostJSONReq(ctx, &req, &resp, createTFAApplicationPath)
 
 	return resp, respDetails, err
@@ -386,6 +416,8 @@ func (sms *Channel) GetTFAApplication(
 	ctx context.Context,
 	appID string,
 ) (resp models.GetTFAApplicationResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetTFAApplication", "2fa/2/applications/{appId}")
+
 	respDetails, err = sms.ReqHandler.GetRequest(ctx, &resp, getTFAApplicationPath+"/"+appID, nil)
 
 	return resp, respDetails, err
@@ -396,6 +428,8 @@ func (sms *Channel) UpdateTFAApplication(
 	appID string,
 	req models.UpdateTFAApplicationRequest,
 ) (resp models.UpdateTFAApplicationResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.UpdateTFAApplication", "2fa/2/applications/{appId}")
+
 	respDetails, err = sms.ReqHandler.PutJSONReq(ctx, &req, &resp, updateTFAApplicationPath+"/"+appID, nil)
 
 	return resp, respDetails, err
@@ -405,6 +439,8 @@ func (sms *Channel) GetTFAMessageTemplates(
 	ctx context.Context,
 	appID string,
 ) (resp models.GetTFAMessageTemplatesResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetTFAMessageTemplates", "2fa/2/applications/{appId}/messages")
+
 	respDetails, err = sms.ReqHandler.GetRequest(ctx, &resp, getTFAMessageTemplatesPath+"/"+appID+"/messages", nil)
 
 	return resp, respDetails, err
@@ -415,6 +451,8 @@ func (sms *Channel) CreateTFAMessageTemplate(
 	appID string,
 	req models.CreateTFAMessageTemplateRequest,
 ) (resp models.CreateTFAMessageTemplateResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.CreateTFAMessageTemplate", "2fa/2/applications/{appId}/messages")
+
 	respDetails, err = sms.ReqHandler.PostJSONReq(ctx, &req, &resp, createTFAMessageTemplatePath+"/"+appID+"/messages")
 
 	return resp, respDetails, err
@@ -425,6 +463,8 @@ func (sms *Channel) GetTFAMessageTemplate(
 	appID string,
 	templateID string,
 ) (resp models.GetTFAMessageTemplateResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetTFAMessageTemplate", "2fa/2/applications/{appId}/messages/{msgId}")
+
 	respDetails, err = sms.ReqHandler.GetRequest(ctx,
 		&resp,
 		getTFAMessageTemplatePath+"/"+appID+"/messages/"+templateID,
@@ -439,6 +479,8 @@ func (sms *Channel) UpdateTFAMessageTemplate(
 	messageID string,
 	req models.UpdateTFAMessageTemplateRequest,
 ) (resp models.UpdateTFAMessageTemplateResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.UpdateTFAMessageTemplate", "2fa/2/applications/{appId}/messages/{msgId}")
+
 	respDetails, err = sms.ReqHandler.PutJSONReq(
 		ctx,
 		&req,
@@ -455,6 +497,8 @@ func (sms *Channel) SendPINOverSMS(
 	queryParams models.SendPINOverSMSParams,
 	req models.SendPINOverSMSRequest,
 ) (resp models.SendPINOverSMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.SendPINOverSMS", sendPINOverSMSPath)
+
 	params := []internal.QueryParameter{
 		{Name: "ncNeeded", Value: fmt.Sprint(queryParams.NCNeeded)},
 	}
@@ -469,6 +513,8 @@ func (sms *Channel) ResendPINOverSMS(
 	pinID string,
 	req models.ResendPINOverSMSRequest,
 ) (resp models.ResendPINOverSMSResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.ResendPINOverSMS", "2fa/2/pin/{pinId}/resend")
+
 	respDetails, err = sms.ReqHandler.PostJSONReq(ctx, &req, &resp, resendPINOverSMSPath+"/"+pinID+"/resend")
 
 	return resp, respDetails, err
@@ -478,6 +524,8 @@ func (sms *Channel) SendPINOverVoice(
 	ctx context.Context,
 	req models.SendPINOverVoiceRequest,
 ) (resp models.SendPINOverVoiceResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.SendPINOverVoice", sendPINOverVoicePath)
+
 	respDetails, err = sms.ReqHandler.PostJSONReq(ctx, &req, &resp, sendPINOverVoicePath)
 
 	return resp, respDetails, err
@@ -488,6 +536,8 @@ func (sms *Channel) ResendPINOverVoice(
 	pinID string,
 	req models.ResendPINOverVoiceRequest,
 ) (resp models.ResendPINOverVoiceResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.ResendPINOverVoice", "2fa/2/pin/{pinId}/resend/voice")
+
 	respDetails, err = sms.ReqHandler.PostJSONReq(ctx, &req, &resp, resendPINOverVoicePath+"/"+pinID+"/resend/voice")
 
 	return resp, respDetails, err
@@ -498,6 +548,8 @@ func (sms *Channel) VerifyPhoneNumber(
 	pinID string,
 	req models.VerifyPhoneNumberRequest,
 ) (resp models.VerifyPhoneNumberResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.VerifyPhoneNumber", "2fa/2/pin/{pinId}/verify")
+
 	respDetails, err = sms.ReqHandler.PostJSONReq(ctx, &req, &resp, verifyPhoneNumberPath+"/"+pinID+"/verify")
 
 	return resp, respDetails, err
@@ -508,6 +560,8 @@ func (sms *Channel) GetTFAVerificationStatus(
 	appID string,
 	queryParams models.GetTFAVerificationStatusParams,
 ) (resp models.GetTFAVerificationStatusResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "SMS.GetTFAVerificationStatus", "2fa/2/applications/{appId}/verifications")
+
 	params := []internal.QueryParameter{
 		{Name: "msisdn", Value: queryParams.MSISDN},
 		{Name: "verified", Value: fmt.Sprint(queryParams.Verified)},
@@ -0,0 +1,85 @@
+package smsencoding
+
+// Language codes accepted by PreviewSMSRequest. Languages select a national language single shift table of
+// 3GPP TS 23.038, which adds characters of the language to the GSM-7 extension table.
+const (
+	LanguageTurkish    = "TR"
+	LanguageSpanish    = "ES"
+	LanguagePortuguese = "PT"
+	// LanguageAutodetect selects the first language whose shift table can encode the text in GSM-7, if the
+	// default alphabet cannot.
+	LanguageAutodetect = "AUTODETECT"
+)
+
+const (
+	// gsmBasic is the GSM-7 default alphabet, without the escape character.
+	gsmBasic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+		"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+	// gsmExtension is the GSM-7 default extension table. Its characters take two septets, as they are preceded
+	// by the escape character.
+	gsmExtension = "\f^{}\\[~]|€"
+	// Single shift tables replace the default extension table.
+	turkishShift    = "\f^{}\\[~]|ĞİŞç€ğış"
+	spanishShift    = "ç\f^{}\\[~]|ÁÍÓÚá€íóú"
+	portugueseShift = "êç\fÔôÁá^ΦΓ{}ΩΠΨΣΘ\\Ê[~]|ÀÍÓÚÃÕÂ€íóúãõâ"
+)
+
+// alphabet is a GSM-7 alphabet: the default one, or the default one with a single shift table.
+type alphabet struct {
+	basic     map[rune]bool
+	extension map[rune]bool
+	// shift tells whether the alphabet uses a single shift table, which is announced in the user data header.
+	shift bool
+}
+
+// nolint: gochecknoglobals // read-only
+var (
+	defaultAlphabet   = newAlphabet(gsmExtension, false)
+	languageAlphabets = map[string]alphabet{
+		LanguageTurkish:    newAlphabet(turkishShift, true),
+		LanguageSpanish:    newAlphabet(spanishShift, true),
+		LanguagePortuguese: newAlphabet(portugueseShift, true),
+	}
+	// autodetectOrder is the order in which languages are tried by LanguageAutodetect.
+	autodetectOrder = []string{LanguageTurkish, LanguageSpanish, LanguagePortuguese}
+)
+
+func newAlphabet(extension string, shift bool) alphabet {
+	a := alphabet{basic: map[rune]bool{}, extension: map[rune]bool{}, shift: shift}
+	for _, c := range gsmBasic {
+		a.basic[c] = true
+	}
+	for _, c := range extension {
+		a.extension[c] = true
+	}
+
+	return a
+}
+
+// septets returns the number of septets encoding c, or 0 if the alphabet cannot encode it.
+func (a alphabet) septets(c rune) int {
+	switch {
+	case a.basic[c]:
+		return 1
+	case a.extension[c]:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// encodes tells whether the alphabet can encode text.
+func (a alphabet) encodes(text string) bool {
+	for _, c := range text {
+		if a.septets(c) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsGSM7 tells whether text can be encoded in GSM-7 with the default alphabet and extension table.
+func IsGSM7(text string) bool {
+	return defaultAlphabet.encodes(text)
+}
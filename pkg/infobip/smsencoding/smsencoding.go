@@ -0,0 +1,199 @@
+// Package smsencoding computes offline how a text is encoded and split into SMS messages, e.g. to estimate costs
+// without calling SMS.Preview:
+//
+//	segments := smsencoding.Calculate(text, smsencoding.Config{Transliteration: smsencoding.TransliterationTurkish})
+//	log.Printf("%d messages, %d characters left", segments.MessageCount, segments.CharactersRemaining)
+//
+// Texts which can be encoded in GSM-7, with the default alphabet and extension table or with the single shift table
+// of a language, take 7 bits per character, and characters of the extension or shift table take two. Other texts
+// are encoded in UCS-2, taking 16 bits per UTF-16 code unit. Texts longer than a single message are split into
+// concatenated messages, whose headers take some of the 140 bytes of each message.
+package smsencoding
+
+import (
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// Encoding is the encoding of the messages of a text.
+type Encoding string
+
+const (
+	GSM7 Encoding = "GSM-7"
+	UCS2 Encoding = "UCS-2"
+)
+
+const (
+	messageBytes = 140
+	// concatenationHeaderBytes are the user data header length and concatenation information element.
+	concatenationHeaderBytes = 6
+	// shiftHeaderBytes are the single shift information element, along with the user data header length when
+	// the message has no other information element.
+	shiftHeaderBytes  = 3
+	headerLengthBytes = 1
+	septetBits        = 7
+	byteBits          = 8
+	ucs2CodeUnitBytes = 2
+	// supplementaryCodeUnits is the UTF-16 length of characters beyond the basic multilingual plane.
+	supplementaryCodeUnits    = 2
+	basicMultilingualPlaneMax = 0xFFFF
+)
+
+// Config is the language and transliteration of a text, as in models.PreviewSMSRequest.
+type Config struct {
+	// LanguageCode is one of the Language constants, or empty to use the default GSM-7 alphabet.
+	LanguageCode string
+	// Transliteration is one of the Transliteration constants, or empty to send the text as is.
+	Transliteration string
+}
+
+// Segments tell how a text is sent.
+type Segments struct {
+	// Text is the text sent, after transliteration.
+	Text     string
+	Encoding Encoding
+	// LanguageCode is the language whose shift table encodes the text, with LanguageAutodetect resolved. It is empty
+	// when the text is encoded with the default GSM-7 alphabet or in UCS-2.
+	LanguageCode string
+	// Length is the length of the text, in septets for GSM-7 and in UTF-16 code units for UCS-2.
+	Length       int
+	MessageCount int
+	// CharactersRemaining is the length which can be added to the text without sending another message, in the
+	// unit of Length.
+	CharactersRemaining int
+}
+
+// Calculate computes how a text is sent with config.
+func Calculate(text string, config Config) Segments {
+	text = Transliterate(text, config.Transliteration)
+	segments := Segments{Text: text, Encoding: UCS2}
+
+	var units []int
+	if language, a, ok := gsmAlphabet(text, config.LanguageCode); ok {
+		segments.Encoding = GSM7
+		segments.LanguageCode = language
+		for _, c := range text {
+			units = append(units, a.septets(c))
+		}
+		single := septetCapacity(headerBytes(false, a.shift))
+		multipart := septetCapacity(headerBytes(true, a.shift))
+		segments.fill(units, single, multipart)
+
+		return segments
+	}
+
+	for _, c := range text {
+		n := 1
+		if c > basicMultilingualPlaneMax {
+			n = supplementaryCodeUnits
+		}
+		units = append(units, n)
+	}
+	single := (messageBytes - headerBytes(false, false)) / ucs2CodeUnitBytes
+	multipart := (messageBytes - headerBytes(true, false)) / ucs2CodeUnitBytes
+	segments.fill(units, single, multipart)
+
+	return segments
+}
+
+// gsmAlphabet returns the GSM-7 alphabet encoding text with languageCode, if any.
+func gsmAlphabet(text string, languageCode string) (string, alphabet, bool) {
+	switch languageCode {
+	case "":
+		return "", defaultAlphabet, defaultAlphabet.encodes(text)
+	case LanguageAutodetect:
+		if defaultAlphabet.encodes(text) {
+			return "", defaultAlphabet, true
+		}
+		for _, language := range autodetectOrder {
+			if a := languageAlphabets[language]; a.encodes(text) {
+				return language, a, true
+			}
+		}
+
+		return "", alphabet{}, false
+	default:
+		a, ok := languageAlphabets[languageCode]
+		if !ok {
+			a = defaultAlphabet
+			languageCode = ""
+		}
+
+		return languageCode, a, a.encodes(text)
+	}
+}
+
+// fill splits characters taking units into messages, never splitting a character across two messages.
+func (s *Segments) fill(units []int, single int, multipart int) {
+	for _, n := range units {
+		s.Length += n
+	}
+	s.MessageCount = 1
+	if s.Length <= single {
+		s.CharactersRemaining = single - s.Length
+		return
+	}
+
+	used := 0
+	for _, n := range units {
+		if used+n > multipart {
+			s.MessageCount++
+			used = 0
+		}
+		used += n
+	}
+	s.CharactersRemaining = multipart - used
+}
+
+func headerBytes(concatenated bool, shift bool) int {
+	n := 0
+	if concatenated {
+		n += concatenationHeaderBytes
+	}
+	if shift {
+		n += shiftHeaderBytes
+		if !concatenated {
+			n += headerLengthBytes
+		}
+	}
+
+	return n
+}
+
+func septetCapacity(headerBytes int) int {
+	return (messageBytes - headerBytes) * byteBits / septetBits
+}
+
+// Preview computes offline what SMS.Preview returns: a preview of the text as is, and previews with the language,
+// the transliteration, and both, when the request sets them.
+func Preview(req models.PreviewSMSRequest) (models.PreviewSMSResponse, error) {
+	if err := req.Validate(); err != nil {
+		return models.PreviewSMSResponse{}, err
+	}
+
+	configs := []Config{{}}
+	if req.LanguageCode != "" {
+		configs = append(configs, Config{LanguageCode: req.LanguageCode})
+	}
+	if req.Transliteration != "" {
+		configs = append(configs, Config{Transliteration: req.Transliteration})
+	}
+	if req.LanguageCode != "" && req.Transliteration != "" {
+		configs = append(configs, Config{LanguageCode: req.LanguageCode, Transliteration: req.Transliteration})
+	}
+
+	resp := models.PreviewSMSResponse{OriginalText: req.Text}
+	for _, config := range configs {
+		segments := Calculate(req.Text, config)
+		resp.Previews = append(resp.Previews, models.SMSPreview{
+			CharactersRemaining: segments.CharactersRemaining,
+			Configuration: models.SMSPreviewConfiguration{
+				Language:        models.SMSLanguage{LanguageCode: config.LanguageCode},
+				Transliteration: config.Transliteration,
+			},
+			MessageCount: segments.MessageCount,
+			TextPreview:  segments.Text,
+		})
+	}
+
+	return resp, nil
+}
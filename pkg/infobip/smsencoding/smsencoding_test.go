@@ -0,0 +1,140 @@
+package smsencoding
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		config    Config
+		encoding  Encoding
+		language  string
+		length    int
+		count     int
+		remaining int
+	}{
+		{name: "empty", text: "", encoding: GSM7, count: 1, remaining: 160},
+		{name: "single GSM-7", text: "Hello", encoding: GSM7, length: 5, count: 1, remaining: 155},
+		{name: "full GSM-7", text: strings.Repeat("a", 160), encoding: GSM7, length: 160, count: 1},
+		{name: "two GSM-7", text: strings.Repeat("a", 161), encoding: GSM7, length: 161, count: 2, remaining: 145},
+		{name: "extension", text: "{€}", encoding: GSM7, length: 6, count: 1, remaining: 154},
+		{
+			name:     "extension is not split",
+			text:     strings.Repeat("a", 152) + "€" + strings.Repeat("a", 8),
+			encoding: GSM7, length: 162, count: 2, remaining: 143,
+		},
+		{name: "UCS-2", text: "Ünïcödé", encoding: UCS2, length: 7, count: 1, remaining: 63},
+		{name: "two UCS-2", text: strings.Repeat("ж", 71), encoding: UCS2, length: 71, count: 2, remaining: 63},
+		{name: "surrogate pair", text: "Hi 👋", encoding: UCS2, length: 5, count: 1, remaining: 65},
+		{
+			name:     "surrogate pair is not split",
+			text:     strings.Repeat("ж", 66) + "👋" + strings.Repeat("ж", 3),
+			encoding: UCS2, length: 71, count: 2, remaining: 62,
+		},
+		{
+			name:     "language",
+			text:     "Günaydın",
+			config:   Config{LanguageCode: LanguageTurkish},
+			encoding: GSM7, language: LanguageTurkish, length: 9, count: 1, remaining: 146,
+		},
+		{
+			name:     "language concatenated",
+			text:     strings.Repeat("ş", 78),
+			config:   Config{LanguageCode: LanguageTurkish},
+			encoding: GSM7, language: LanguageTurkish, length: 156, count: 2, remaining: 141,
+		},
+		{
+			name:     "language cannot encode",
+			text:     "Günaydın 👋",
+			config:   Config{LanguageCode: LanguageTurkish},
+			encoding: UCS2, length: 11, count: 1, remaining: 59,
+		},
+		{
+			name:     "autodetect",
+			text:     "Olá",
+			config:   Config{LanguageCode: LanguageAutodetect},
+			encoding: GSM7, language: LanguageSpanish, length: 4, count: 1, remaining: 151,
+		},
+		{
+			name:     "autodetect default alphabet",
+			text:     "Hello",
+			config:   Config{LanguageCode: LanguageAutodetect},
+			encoding: GSM7, length: 5, count: 1, remaining: 155,
+		},
+		{
+			name:     "transliteration",
+			text:     "Günaydın",
+			config:   Config{Transliteration: TransliterationTurkish},
+			encoding: GSM7, length: 8, count: 1, remaining: 152,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			segments := Calculate(tc.text, tc.config)
+			assert.Equal(t, tc.encoding, segments.Encoding)
+			assert.Equal(t, tc.language, segments.LanguageCode)
+			assert.Equal(t, tc.length, segments.Length)
+			assert.Equal(t, tc.count, segments.MessageCount)
+			assert.Equal(t, tc.remaining, segments.CharactersRemaining)
+		})
+	}
+}
+
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		transliteration string
+		text            string
+		expected        string
+	}{
+		{TransliterationTurkish, "Işık Şahin çay", "Isik Sahin cay"},
+		{TransliterationGreek, "Καλημέρα", "KAΛHMEPA"},
+		{TransliterationCyrillic, "Жёлтый Щит", "Zhyoltyy Schit"},
+		{TransliterationSerbianCyrillic, "Ђорђе Љубић", "Djordje Ljubic"},
+		{TransliterationCentralEuropean, "Łódź Žilina Brașov", "Lodz Zilina Brasov"},
+		{TransliterationBaltic, "Rīga Šiauliai", "Riga Siauliai"},
+		{TransliterationNonUnicode, "“Привет” — Ελλάδα…", "\"Privet\" - EΛΛAΔA..."},
+		{TransliterationTurkish, "Привет", "Привет"},
+		{"", "Işık", "Işık"},
+		{"UNKNOWN", "Işık", "Işık"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.transliteration, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Transliterate(tc.text, tc.transliteration))
+		})
+	}
+}
+
+func TestIsGSM7(t *testing.T) {
+	assert.True(t, IsGSM7("Hello [world] €5 @ Ångström"))
+	assert.False(t, IsGSM7("Günaydın"))
+}
+
+func TestPreview(t *testing.T) {
+	text := "Let's see how many characters will remain unused in this message ."
+	resp, err := Preview(models.PreviewSMSRequest{Text: text, LanguageCode: "TR", Transliteration: "TURKISH"})
+	require.NoError(t, err)
+
+	assert.Equal(t, text, resp.OriginalText)
+	require.Len(t, resp.Previews, 4)
+	assert.Equal(t, 94, resp.Previews[0].CharactersRemaining)
+	assert.Equal(t, models.SMSPreviewConfiguration{}, resp.Previews[0].Configuration)
+	assert.Equal(t, 89, resp.Previews[1].CharactersRemaining)
+	assert.Equal(t, "TR", resp.Previews[1].Configuration.Language.LanguageCode)
+	assert.Equal(t, "TURKISH", resp.Previews[2].Configuration.Transliteration)
+	assert.Equal(t, "TR", resp.Previews[3].Configuration.Language.LanguageCode)
+	assert.Equal(t, "TURKISH", resp.Previews[3].Configuration.Transliteration)
+	for _, preview := range resp.Previews {
+		assert.Equal(t, 1, preview.MessageCount)
+		assert.Equal(t, text, preview.TextPreview)
+	}
+
+	_, err = Preview(models.PreviewSMSRequest{Text: "Hi", LanguageCode: "XX"})
+	require.Error(t, err)
+}
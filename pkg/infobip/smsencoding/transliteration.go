@@ -0,0 +1,122 @@
+package smsencoding
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Transliterations accepted by PreviewSMSRequest. They replace characters of a script or language which GSM-7
+// cannot encode with characters it can, so that texts are not sent in UCS-2.
+const (
+	TransliterationTurkish         = "TURKISH"
+	TransliterationGreek           = "GREEK"
+	TransliterationCyrillic        = "CYRILLIC"
+	TransliterationSerbianCyrillic = "SERBIAN_CYRILLIC"
+	TransliterationCentralEuropean = "CENTRAL_EUROPEAN"
+	TransliterationBaltic          = "BALTIC"
+	// TransliterationNonUnicode applies all other transliterations, and replaces typographic punctuation.
+	TransliterationNonUnicode = "NON_UNICODE"
+)
+
+// nolint: gochecknoglobals // read-only
+var transliterations = map[string]map[rune]string{
+	TransliterationTurkish: withUpperCase(map[rune]string{
+		'ç': "c", 'ğ': "g", 'ı': "i", 'İ': "I", 'ş': "s", 'â': "a", 'î': "i", 'û': "u",
+	}),
+	TransliterationGreek: map[rune]string{
+		'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "I", 'Κ': "K", 'Μ': "M", 'Ν': "N", 'Ο': "O",
+		'Ρ': "P", 'Τ': "T", 'Υ': "Y", 'Χ': "X", 'Ά': "A", 'Έ': "E", 'Ή': "H", 'Ί': "I", 'Ό': "O", 'Ύ': "Y",
+		'Ώ': "Ω", 'Ϊ': "I", 'Ϋ': "Y",
+		'α': "A", 'β': "B", 'γ': "Γ", 'δ': "Δ", 'ε': "E", 'ζ': "Z", 'η': "H", 'θ': "Θ", 'ι': "I", 'κ': "K",
+		'λ': "Λ", 'μ': "M", 'ν': "N", 'ξ': "Ξ", 'ο': "O", 'π': "Π", 'ρ': "P", 'σ': "Σ", 'ς': "Σ", 'τ': "T",
+		'υ': "Y", 'φ': "Φ", 'χ': "X", 'ψ': "Ψ", 'ω': "Ω", 'ά': "A", 'έ': "E", 'ή': "H", 'ί': "I", 'ό': "O",
+		'ύ': "Y", 'ώ': "Ω", 'ϊ': "I", 'ϋ': "Y", 'ΐ': "I", 'ΰ': "Y",
+	},
+	TransliterationCyrillic: withUpperCase(map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh", 'з': "z", 'и': "i",
+		'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+		'у': "u", 'ф': "f", 'х': "h", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "\"", 'ы': "y",
+		'ь': "'", 'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g",
+	}),
+	TransliterationSerbianCyrillic: withUpperCase(map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'ђ': "dj", 'е': "e", 'ж': "z", 'з': "z", 'и': "i",
+		'ј': "j", 'к': "k", 'л': "l", 'љ': "lj", 'м': "m", 'н': "n", 'њ': "nj", 'о': "o", 'п': "p", 'р': "r",
+		'с': "s", 'т': "t", 'ћ': "c", 'у': "u", 'ф': "f", 'х': "h", 'ц': "c", 'ч': "c", 'џ': "dz", 'ш': "s",
+	}),
+	TransliterationCentralEuropean: withUpperCase(map[rune]string{
+		'á': "a", 'ą': "a", 'â': "a", 'ă': "a", 'č': "c", 'ć': "c", 'ď': "d", 'đ': "dj", 'ę': "e", 'ě': "e",
+		'í': "i", 'î': "i", 'ľ': "l", 'ĺ': "l", 'ł': "l", 'ń': "n", 'ň': "n", 'ó': "o", 'ô': "o", 'ő': "o",
+		'ŕ': "r", 'ř': "r", 'ś': "s", 'š': "s", 'ș': "s", 'ş': "s", 'ť': "t", 'ț': "t", 'ţ': "t", 'ú': "u",
+		'ů': "u", 'ű': "u", 'ý': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+	}),
+	TransliterationBaltic: withUpperCase(map[rune]string{
+		'ā': "a", 'ą': "a", 'č': "c", 'ē': "e", 'ė': "e", 'ę': "e", 'ģ': "g", 'ī': "i", 'į': "i", 'ķ': "k",
+		'ļ': "l", 'ņ': "n", 'õ': "o", 'š': "s", 'ū': "u", 'ų': "u", 'ž': "z",
+	}),
+}
+
+// nolint: gochecknoglobals // read-only
+var punctuation = map[rune]string{
+	'‘': "'", '’': "'", '‚': "'", '′': "'", '“': "\"", '”': "\"", '„': "\"", '″': "\"", '«': "\"", '»': "\"",
+	'‐': "-", '‑': "-", '–': "-", '—': "-", '−': "-", '…': "...", '•': "-", ' ': " ", ' ': " ",
+}
+
+// withUpperCase adds the upper case of the lower case characters of a transliteration, unless GSM-7 can encode
+// them or the transliteration already has them, e.g. 'Ж' to "Zh" from 'ж' to "zh".
+func withUpperCase(transliteration map[rune]string) map[rune]string {
+	for c, replacement := range transliteration {
+		upper := unicode.ToUpper(c)
+		if _, ok := transliteration[upper]; ok || upper == c || defaultAlphabet.septets(upper) > 0 {
+			continue
+		}
+		runes := []rune(replacement)
+		runes[0] = unicode.ToUpper(runes[0])
+		transliteration[upper] = string(runes)
+	}
+
+	return transliteration
+}
+
+// Transliterate replaces the characters of text which GSM-7 cannot encode according to a transliteration. Other
+// characters, and texts with an unknown or empty transliteration, are left as is.
+func Transliterate(text string, transliteration string) string {
+	var tables []map[rune]string
+	switch transliteration {
+	case "":
+		return text
+	case TransliterationNonUnicode:
+		tables = append(tables, punctuation)
+		for _, name := range []string{
+			TransliterationTurkish, TransliterationGreek, TransliterationCyrillic,
+			TransliterationCentralEuropean, TransliterationBaltic,
+		} {
+			tables = append(tables, transliterations[name])
+		}
+	default:
+		table, ok := transliterations[transliteration]
+		if !ok {
+			return text
+		}
+		tables = append(tables, table)
+	}
+
+	var b strings.Builder
+	for _, c := range text {
+		b.WriteString(replace(c, tables))
+	}
+
+	return b.String()
+}
+
+func replace(c rune, tables []map[rune]string) string {
+	if defaultAlphabet.septets(c) > 0 {
+		return string(c)
+	}
+	for _, table := range tables {
+		if replacement, ok := table[c]; ok {
+			return replacement
+		}
+	}
+
+	return string(c)
+}
@@ -0,0 +1,77 @@
+package telemetry
+
+import (
+	"context"
+	"sync"
+)
+
+// InMemoryExporter keeps spans and metrics in memory. It is meant for tests, and is safe for concurrent use.
+type InMemoryExporter struct {
+	mu         sync.Mutex
+	spans      []Span
+	counters   map[string]int64
+	histograms map[string][]float64
+}
+
+// NewInMemoryExporter returns an empty InMemoryExporter.
+func NewInMemoryExporter() *InMemoryExporter {
+	return &InMemoryExporter{counters: map[string]int64{}, histograms: map[string][]float64{}}
+}
+
+// ExportSpan implements SpanExporter.
+func (e *InMemoryExporter) ExportSpan(_ context.Context, span Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.spans = append(e.spans, span)
+}
+
+// AddCounter implements MetricRecorder. Attributes are not kept.
+func (e *InMemoryExporter) AddCounter(_ context.Context, name string, value int64, _ map[string]interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.counters[name] += value
+}
+
+// RecordHistogram implements MetricRecorder. Attributes are not kept.
+func (e *InMemoryExporter) RecordHistogram(_ context.Context, name string, value float64, _ map[string]interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.histograms[name] = append(e.histograms[name], value)
+}
+
+// Spans returns the exported spans, in the order in which their operations ended.
+func (e *InMemoryExporter) Spans() []Span {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]Span(nil), e.spans...)
+}
+
+// Counter returns the current value of the named counter.
+func (e *InMemoryExporter) Counter(name string) int64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.counters[name]
+}
+
+// Histogram returns the values recorded by the named histogram.
+func (e *InMemoryExporter) Histogram(name string) []float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]float64(nil), e.histograms[name]...)
+}
+
+// Reset drops all the spans and metrics.
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.spans = nil
+	e.counters = map[string]int64{}
+	e.histograms = map[string][]float64{}
+}
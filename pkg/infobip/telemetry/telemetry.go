@@ -0,0 +1,159 @@
+// Package telemetry turns the operations reported by an infobip.Client into spans and metrics shaped after the
+// OpenTelemetry semantic conventions, without depending on any telemetry library.
+//
+// Spans and metrics are handed to a SpanExporter and a MetricRecorder, which can be backed by OpenTelemetry,
+// another library, or the InMemoryExporter provided for tests:
+//
+//	exporter := telemetry.NewInMemoryExporter()
+//	client, err := infobip.NewClient(baseURL, apiKey,
+//		infobip.WithInstrumentation(telemetry.New(exporter, exporter)))
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip"
+)
+
+// Span attribute keys.
+const (
+	AttrOperation  = "infobip.operation"
+	AttrMethod     = "http.request.method"
+	AttrRoute      = "url.template"
+	AttrPath       = "url.path"
+	AttrStatusCode = "http.response.status_code"
+	AttrAttempts   = "http.request.resend_count"
+	AttrBulkID     = "infobip.bulk_id"
+	AttrMessageIDs = "infobip.message_ids"
+	AttrErrorType  = "error.type"
+)
+
+// Metric names. Metrics are recorded with the operation, method and status code attributes of their span.
+const (
+	// MetricRequests counts operations.
+	MetricRequests = "infobip.client.requests"
+	// MetricErrors counts operations which failed with a transport error or a 4xx/5xx response.
+	MetricErrors = "infobip.client.errors"
+	// MetricDuration records the duration of operations in seconds.
+	MetricDuration = "infobip.client.duration"
+)
+
+// SpanStatus is the status of a span.
+type SpanStatus int
+
+const (
+	StatusUnset SpanStatus = iota
+	StatusError
+)
+
+// Span describes a finished operation.
+type Span struct {
+	// Name is the operation name, e.g. "SMS.Send".
+	Name              string
+	StartTime         time.Time
+	EndTime           time.Time
+	Attributes        map[string]interface{}
+	Status            SpanStatus
+	StatusDescription string
+}
+
+// SpanExporter receives spans once their operation is done. The context is the one the operation was called
+// with, so that exporters can link spans to their parent.
+type SpanExporter interface {
+	ExportSpan(ctx context.Context, span Span)
+}
+
+// MetricRecorder receives the metrics of every operation.
+type MetricRecorder interface {
+	AddCounter(ctx context.Context, name string, value int64, attributes map[string]interface{})
+	RecordHistogram(ctx context.Context, name string, value float64, attributes map[string]interface{})
+}
+
+// Instrumentation implements infobip.Instrumentation. Use New to create one.
+type Instrumentation struct {
+	spans   SpanExporter
+	metrics MetricRecorder
+}
+
+// New returns an Instrumentation exporting spans and recording metrics to the given destinations, either of
+// which can be nil.
+func New(spans SpanExporter, metrics MetricRecorder) *Instrumentation {
+	return &Instrumentation{spans: spans, metrics: metrics}
+}
+
+// StartOperation implements infobip.Instrumentation.
+func (i *Instrumentation) StartOperation(
+	ctx context.Context,
+	start infobip.OperationStart,
+) (context.Context, infobip.OperationSpan) {
+	return ctx, &operationSpan{instrumentation: i, ctx: ctx, start: start, startTime: time.Now()}
+}
+
+type operationSpan struct {
+	instrumentation *Instrumentation
+	ctx             context.Context
+	start           infobip.OperationStart
+	startTime       time.Time
+}
+
+func (s *operationSpan) End(end infobip.OperationEnd) {
+	span := Span{
+		Name:      s.start.Operation.Name,
+		StartTime: s.startTime,
+		EndTime:   s.startTime.Add(end.Latency),
+		Attributes: map[string]interface{}{
+			AttrOperation: s.start.Operation.Name,
+			AttrMethod:    s.start.Method,
+			AttrRoute:     s.start.Operation.PathTemplate,
+			AttrPath:      s.start.Path,
+		},
+	}
+	metricAttributes := map[string]interface{}{
+		AttrOperation: s.start.Operation.Name,
+		AttrMethod:    s.start.Method,
+	}
+
+	if end.StatusCode != 0 {
+		span.Attributes[AttrStatusCode] = end.StatusCode
+		metricAttributes[AttrStatusCode] = end.StatusCode
+	}
+	if end.Attempts > 1 {
+		span.Attributes[AttrAttempts] = end.Attempts - 1
+	}
+	if end.BulkID != "" {
+		span.Attributes[AttrBulkID] = end.BulkID
+	}
+	if len(end.MessageIDs) > 0 {
+		span.Attributes[AttrMessageIDs] = end.MessageIDs
+	}
+
+	failed := true
+	switch {
+	case end.Err != nil:
+		span.StatusDescription = end.Err.Error()
+		span.Attributes[AttrErrorType] = "transport"
+	case end.StatusCode >= http.StatusBadRequest:
+		span.StatusDescription = http.StatusText(end.StatusCode)
+		span.Attributes[AttrErrorType] = strconv.Itoa(end.StatusCode)
+	default:
+		failed = false
+	}
+	if failed {
+		span.Status = StatusError
+		metricAttributes[AttrErrorType] = span.Attributes[AttrErrorType]
+	}
+
+	if s.instrumentation.spans != nil {
+		s.instrumentation.spans.ExportSpan(s.ctx, span)
+	}
+	if metrics := s.instrumentation.metrics; metrics != nil {
+		metrics.AddCounter(s.ctx, MetricRequests, 1, metricAttributes)
+		if failed {
+			metrics.AddCounter(s.ctx, MetricErrors, 1, metricAttributes)
+		}
+		metrics.RecordHistogram(s.ctx, MetricDuration, end.Latency.Seconds(), metricAttributes)
+	}
+}
@@ -0,0 +1,108 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrumentationExportsSpansAndMetrics(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusOK)
+			_, servErr := w.Write([]byte(`{
+				"bulkId": "some-bulk-id",
+				"messages": [{"messageId": "first-id"}, {"messageId": "second-id"}]
+			}`))
+			assert.Nil(t, servErr)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusOK)
+			_, servErr := w.Write([]byte(`{"templates": []}`))
+			assert.Nil(t, servErr)
+		}
+	}))
+	defer serv.Close()
+
+	exporter := NewInMemoryExporter()
+	client, err := infobip.NewClient(serv.URL, "secret", infobip.WithInstrumentation(New(exporter, exporter)))
+	require.NoError(t, err)
+
+	_, _, err = client.SMS.Send(context.Background(), models.SendSMSRequest{
+		Messages: []models.SMSMsg{{Destinations: []models.SMSDestination{{To: "41793026727"}}, Text: "Hi!"}},
+	})
+	require.NoError(t, err)
+	_, _, err = client.WhatsApp.GetTemplates(context.Background(), "111111111111")
+	require.NoError(t, err)
+	_, err = client.WhatsApp.DeleteTemplate(context.Background(), "111111111111", "some_template")
+	require.NoError(t, err)
+
+	spans := exporter.Spans()
+	require.Len(t, spans, 3)
+
+	assert.Equal(t, "SMS.Send", spans[0].Name)
+	assert.Equal(t, StatusUnset, spans[0].Status)
+	assert.Equal(t, map[string]interface{}{
+		AttrOperation:  "SMS.Send",
+		AttrMethod:     http.MethodPost,
+		AttrRoute:      "sms/2/text/advanced",
+		AttrPath:       "sms/2/text/advanced",
+		AttrStatusCode: http.StatusOK,
+		AttrBulkID:     "some-bulk-id",
+		AttrMessageIDs: []string{"first-id", "second-id"},
+	}, spans[0].Attributes)
+	assert.False(t, spans[0].EndTime.Before(spans[0].StartTime))
+
+	assert.Equal(t, "WhatsApp.GetTemplates", spans[1].Name)
+	assert.Equal(t, "whatsapp/2/senders/{sender}/templates", spans[1].Attributes[AttrRoute])
+	assert.Equal(t, "whatsapp/2/senders/111111111111/templates", spans[1].Attributes[AttrPath])
+	assert.NotContains(t, spans[1].Attributes, AttrMessageIDs)
+
+	assert.Equal(t, "WhatsApp.DeleteTemplate", spans[2].Name)
+	assert.Equal(t, StatusError, spans[2].Status)
+	assert.Equal(t, "Not Found", spans[2].StatusDescription)
+	assert.Equal(t, "404", spans[2].Attributes[AttrErrorType])
+
+	assert.Equal(t, int64(3), exporter.Counter(MetricRequests))
+	assert.Equal(t, int64(1), exporter.Counter(MetricErrors))
+	assert.Len(t, exporter.Histogram(MetricDuration), 3)
+
+	exporter.Reset()
+	assert.Empty(t, exporter.Spans())
+	assert.Zero(t, exporter.Counter(MetricRequests))
+}
+
+func TestInstrumentationTransportError(t *testing.T) {
+	exporter := NewInMemoryExporter()
+	client, err := infobip.NewClient(
+		"https://example.com",
+		"secret",
+		infobip.WithInstrumentation(New(exporter, nil)),
+		infobip.WithRetryPolicy(infobip.RetryPolicy{MaxAttempts: 2}),
+		infobip.WithMiddleware(func(next infobip.Doer) infobip.Doer {
+			return infobip.DoerFunc(func(req *http.Request) (*http.Response, error) {
+				return nil, assert.AnError
+			})
+		}),
+	)
+	require.NoError(t, err)
+
+	_, _, err = client.SMS.GetTFAApplications(context.Background())
+	require.Error(t, err)
+
+	spans := exporter.Spans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, StatusError, spans[0].Status)
+	assert.Equal(t, "transport", spans[0].Attributes[AttrErrorType])
+	assert.Equal(t, 1, spans[0].Attributes[AttrAttempts])
+	assert.NotContains(t, spans[0].Attributes, AttrStatusCode)
+	assert.Zero(t, exporter.Counter(MetricRequests))
+}
@@ -0,0 +1,70 @@
+package webhooks
+
+import "github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+
+// SMSDeliveryReport is posted to the NotifyURL of an SMS message once its delivery is done.
+type SMSDeliveryReport struct {
+	BulkID        string           `json:"bulkId"`
+	MessageID     string           `json:"messageId"`
+	To            string           `json:"to"`
+	From          string           `json:"from"`
+	SentAt        string           `json:"sentAt"`
+	DoneAt        string           `json:"doneAt"`
+	SMSCount      int              `json:"smsCount"`
+	MCCMNC        string           `json:"mccMnc"`
+	CallbackData  string           `json:"callbackData"`
+	Price         models.SMSPrice  `json:"price"`
+	Status        models.SMSStatus `json:"status"`
+	Error         models.SMSError  `json:"error"`
+	EntityID      string           `json:"entityId"`
+	ApplicationID string           `json:"applicationId"`
+}
+
+// MMSDeliveryReport is posted to the NotifyURL of an MMS message once its delivery is done.
+type MMSDeliveryReport = models.OutboundMMSDeliveryResult
+
+// WhatsAppDeliveryReport is posted to the NotifyURL of a WhatsApp message once its delivery is done.
+type WhatsAppDeliveryReport struct {
+	BulkID       string           `json:"bulkId"`
+	MessageID    string           `json:"messageId"`
+	To           string           `json:"to"`
+	SentAt       string           `json:"sentAt"`
+	DoneAt       string           `json:"doneAt"`
+	MessageCount int              `json:"messageCount"`
+	CallbackData string           `json:"callbackData"`
+	Price        models.SMSPrice  `json:"price"`
+	Status       models.SMSStatus `json:"status"`
+	Error        models.SMSError  `json:"error"`
+	Channel      string           `json:"channel"`
+}
+
+// RCSDeliveryReport is posted to the NotifyURL of an RCS message once its delivery is done.
+type RCSDeliveryReport struct {
+	BulkID       string           `json:"bulkId"`
+	MessageID    string           `json:"messageId"`
+	To           string           `json:"to"`
+	SentAt       string           `json:"sentAt"`
+	DoneAt       string           `json:"doneAt"`
+	MessageCount int              `json:"messageCount"`
+	MCCMNC       string           `json:"mccMnc"`
+	CallbackData string           `json:"callbackData"`
+	Price        models.SMSPrice  `json:"price"`
+	Status       models.SMSStatus `json:"status"`
+	Error        models.SMSError  `json:"error"`
+	Channel      string           `json:"channel"`
+}
+
+// EmailDeliveryReport is posted to the NotifyURL of an email once its delivery is done.
+type EmailDeliveryReport struct {
+	BulkID       string           `json:"bulkId"`
+	MessageID    string           `json:"messageId"`
+	To           string           `json:"to"`
+	SentAt       string           `json:"sentAt"`
+	DoneAt       string           `json:"doneAt"`
+	MessageCount int              `json:"messageCount"`
+	CallbackData string           `json:"callbackData"`
+	Price        models.SMSPrice  `json:"price"`
+	Status       models.SMSStatus `json:"status"`
+	Error        models.SMSError  `json:"error"`
+	Channel      string           `json:"channel"`
+}
@@ -0,0 +1,218 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const smsReports = `{
+	"results": [
+		{
+			"bulkId": "BULK-ID-123-xyz",
+			"messageId": "MESSAGE-ID-123-xyz",
+			"to": "41793026727",
+			"sentAt": "2019-11-09T16:00:00.000+0000",
+			"doneAt": "2019-11-09T16:00:00.000+0000",
+			"smsCount": 1,
+			"mccMnc": "22801",
+			"callbackData": "some-data",
+			"price": {"pricePerMessage": 0.01, "currency": "EUR"},
+			"status": {
+				"groupId": 3,
+				"groupName": "DELIVERED",
+				"id": 5,
+				"name": "DELIVERED_TO_HANDSET",
+				"description": "Message delivered to handset"
+			},
+			"error": {
+				"groupId": 0,
+				"groupName": "Ok",
+				"id": 0,
+				"name": "NO_ERROR",
+				"description": "No Error",
+				"permanent": false
+			}
+		}
+	]
+}`
+
+func postReports(handler http.Handler, method string, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(method, "/reports", strings.NewReader(body)))
+	return recorder
+}
+
+func TestDeliveryReportHandlerSMS(t *testing.T) {
+	var reports []SMSDeliveryReport
+	handler := DeliveryReportHandler{OnSMS: func(ctx context.Context, report SMSDeliveryReport) error {
+		reports = append(reports, report)
+		return nil
+	}}
+
+	resp := postReports(&handler, http.MethodPost, smsReports)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	require.Len(t, reports, 1)
+	assert.Equal(t, "MESSAGE-ID-123-xyz", reports[0].MessageID)
+	assert.Equal(t, 1, reports[0].SMSCount)
+	assert.Equal(t, models.SMSPrice{PricePerMessage: 0.01, Currency: "EUR"}, reports[0].Price)
+	assert.Equal(t, "DELIVERED", reports[0].Status.GroupName)
+	assert.Equal(t, "NO_ERROR", reports[0].Error.Name)
+}
+
+func TestDeliveryReportHandlerDetectsChannel(t *testing.T) {
+	var received []string
+	handler := DeliveryReportHandler{
+		OnMMS: func(ctx context.Context, report MMSDeliveryReport) error {
+			received = append(received, "MMS "+report.MessageID+" "+report.Status.GroupName)
+			return nil
+		},
+		OnWhatsApp: func(ctx context.Context, report WhatsAppDeliveryReport) error {
+			received = append(received, "WhatsApp "+report.MessageID+" "+report.Status.GroupName)
+			return nil
+		},
+		OnRCS: func(ctx context.Context, report RCSDeliveryReport) error {
+			received = append(received, "RCS "+report.MessageID)
+			return nil
+		},
+		OnEmail: func(ctx context.Context, report EmailDeliveryReport) error {
+			received = append(received, "Email "+report.MessageID+" "+report.Error.Name)
+			return nil
+		},
+	}
+
+	resp := postReports(&handler, http.MethodPost, `{"results": [
+		{"messageId": "1", "mmsCount": 1, "status": {"groupName": "PENDING"}},
+		{"messageId": "2", "channel": "WHATSAPP", "messageCount": 1, "status": {"groupName": "DELIVERED"}},
+		{"messageId": "3", "channel": "RCS", "messageCount": 1},
+		{"messageId": "4", "channel": "email", "messageCount": 1, "error": {"name": "NO_ERROR"}}
+	]}`)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.Equal(t, []string{"MMS 1 PENDING", "WhatsApp 2 DELIVERED", "RCS 3", "Email 4 NO_ERROR"}, received)
+}
+
+func TestDeliveryReportHandlerForChannel(t *testing.T) {
+	var reports []WhatsAppDeliveryReport
+	handler := DeliveryReportHandler{OnWhatsApp: func(ctx context.Context, report WhatsAppDeliveryReport) error {
+		reports = append(reports, report)
+		return nil
+	}}
+
+	resp := postReports(handler.ForChannel(ChannelWhatsApp), http.MethodPost,
+		`{"results": [{"messageId": "1", "messageCount": 1}]}`)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	require.Len(t, reports, 1)
+	assert.Equal(t, "1", reports[0].MessageID)
+}
+
+func TestDeliveryReportHandlerErrors(t *testing.T) {
+	handler := DeliveryReportHandler{
+		MaxBodySize: 1024,
+		OnSMS: func(ctx context.Context, report SMSDeliveryReport) error {
+			if report.MessageID == "failing" {
+				return assert.AnError
+			}
+			return nil
+		},
+	}
+
+	tests := []struct {
+		scenario       string
+		method         string
+		body           string
+		expectedStatus int
+	}{
+		{scenario: "wrong method", method: http.MethodGet, expectedStatus: http.StatusMethodNotAllowed},
+		{
+			scenario:       "body too large",
+			method:         http.MethodPost,
+			body:           `{"results": [` + strings.Repeat(" ", 1024) + `]}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{scenario: "invalid JSON", method: http.MethodPost, body: `{"results": `, expectedStatus: http.StatusBadRequest},
+		{
+			scenario:       "invalid report",
+			method:         http.MethodPost,
+			body:           `{"results": [{"smsCount": "one"}]}`,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			scenario:       "unknown channel",
+			method:         http.MethodPost,
+			body:           `{"results": [{"messageId": "1"}]}`,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			scenario:       "no callback",
+			method:         http.MethodPost,
+			body:           `{"results": [{"messageId": "1", "channel": "EMAIL"}]}`,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			scenario:       "callback error",
+			method:         http.MethodPost,
+			body:           `{"results": [{"messageId": "failing", "smsCount": 1}]}`,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{scenario: "no results", method: http.MethodPost, body: `{"results": []}`, expectedStatus: http.StatusNoContent},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			resp := postReports(&handler, tc.method, tc.body)
+			assert.Equal(t, tc.expectedStatus, resp.Code)
+		})
+	}
+}
+
+func TestDeliveryReportHandlerSkipsInvalidReports(t *testing.T) {
+	var received []string
+	var invalid []error
+	handler := DeliveryReportHandler{
+		OnSMS: func(ctx context.Context, report SMSDeliveryReport) error {
+			received = append(received, report.MessageID)
+			return nil
+		},
+		OnInvalid: func(ctx context.Context, result json.RawMessage, err error) error {
+			invalid = append(invalid, err)
+			return nil
+		},
+	}
+
+	resp := postReports(&handler, http.MethodPost, `{"results": [
+		{"messageId": "1", "smsCount": 1},
+		{"messageId": "2", "smsCount": "one"},
+		{"messageId": "3"},
+		{"messageId": "4", "channel": "VIBER"},
+		{"messageId": "5", "channel": "EMAIL"},
+		{"messageId": "6", "smsCount": 1}
+	]}`)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.Equal(t, []string{"1", "6"}, received)
+	require.Len(t, invalid, 3)
+	assert.ErrorIs(t, invalid[1], errUnknownChannel)
+	assert.ErrorIs(t, invalid[2], errUnknownChannel)
+}
+
+func TestDeliveryReportHandlerInvalidCallbackError(t *testing.T) {
+	handler := DeliveryReportHandler{
+		OnInvalid: func(ctx context.Context, result json.RawMessage, err error) error {
+			return assert.AnError
+		},
+	}
+
+	resp := postReports(&handler, http.MethodPost, `{"results": [{"messageId": "1"}]}`)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
@@ -0,0 +1,232 @@
+// Package webhooks provides http.Handlers receiving the payloads which Infobip posts back, i.e. delivery reports
+// sent to the NotifyURL of messages and inbound WhatsApp messages, parsing them into typed structs and
+// dispatching them to callbacks.
+//
+// Handlers only accept POST requests. They respond with 204 once all the callbacks have returned without error,
+// with a 4xx status code to bodies which cannot be read or parsed, and with 500 when a callback returns an error,
+// so that Infobip posts the payload again later. Callbacks may therefore receive the same report more than once.
+// Results of a payload which cannot be parsed are passed to the OnInvalid callback of handlers instead, so that
+// they do not prevent the other results from being acknowledged.
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxBodySize is the body size limit of handlers which do not set one.
+const DefaultMaxBodySize = 1 << 20
+
+// Channel identifies the channel of a delivery report.
+type Channel string
+
+const (
+	ChannelSMS      Channel = "SMS"
+	ChannelMMS      Channel = "MMS"
+	ChannelWhatsApp Channel = "WHATSAPP"
+	ChannelRCS      Channel = "RCS"
+	ChannelEmail    Channel = "EMAIL"
+)
+
+var knownChannels = map[Channel]bool{
+	ChannelSMS:      true,
+	ChannelMMS:      true,
+	ChannelWhatsApp: true,
+	ChannelRCS:      true,
+	ChannelEmail:    true,
+}
+
+var (
+	errBodyTooLarge   = errors.New("body too large")
+	errUnknownChannel = errors.New("unknown delivery report channel")
+)
+
+// DeliveryReportHandler receives delivery reports of all channels. Reports are dispatched one by one, in the
+// order of the payload, to the callback of their channel, and ignored if their channel has no callback. Reports
+// which cannot be parsed, or whose channel is unknown, are passed to OnInvalid instead.
+//
+// The channel is taken from the "channel" field of reports when present, and inferred from the "smsCount" and
+// "mmsCount" fields otherwise. Use ForChannel when the payloads posted to an endpoint are known to be of a single
+// channel.
+type DeliveryReportHandler struct {
+	// MaxBodySize limits the size of request bodies, in bytes. Defaults to DefaultMaxBodySize.
+	MaxBodySize int64
+
+	OnSMS      func(ctx context.Context, report SMSDeliveryReport) error
+	OnMMS      func(ctx context.Context, report MMSDeliveryReport) error
+	OnWhatsApp func(ctx context.Context, report WhatsAppDeliveryReport) error
+	OnRCS      func(ctx context.Context, report RCSDeliveryReport) error
+	OnEmail    func(ctx context.Context, report EmailDeliveryReport) error
+	// OnInvalid receives the raw results which cannot be parsed, along with the parsing error. They are ignored
+	// if it is not set.
+	OnInvalid func(ctx context.Context, result json.RawMessage, err error) error
+}
+
+type deliveryReportsPayload struct {
+	Results []json.RawMessage `json:"results"`
+}
+
+// channelProbe holds the fields from which the channel of a report is inferred.
+type channelProbe struct {
+	Channel  string          `json:"channel"`
+	SMSCount json.RawMessage `json:"smsCount"`
+	MMSCount json.RawMessage `json:"mmsCount"`
+}
+
+// ServeHTTP implements http.Handler, inferring the channel of every report.
+func (h *DeliveryReportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, "")
+}
+
+// ForChannel returns a handler parsing every report as a report of the given channel.
+func (h *DeliveryReportHandler) ForChannel(channel Channel) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.serve(w, r, channel)
+	})
+}
+
+func (h *DeliveryReportHandler) serve(w http.ResponseWriter, r *http.Request, channel Channel) {
+	body, ok := readBody(w, r, h.MaxBodySize)
+	if !ok {
+		return
+	}
+
+	var payload deliveryReportsPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, fmt.Sprintf("invalid delivery reports: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	dispatchers := make([]func(ctx context.Context) error, 0, len(payload.Results))
+	for _, result := range payload.Results {
+		var dispatch func(ctx context.Context) error
+		reportChannel, err := detectChannel(result, channel)
+		if err == nil {
+			dispatch, err = h.dispatcher(reportChannel, result)
+		}
+		if err != nil {
+			dispatch = invalidDispatcher(h.OnInvalid, result, err)
+		}
+		if dispatch != nil {
+			dispatchers = append(dispatchers, dispatch)
+		}
+	}
+
+	for _, dispatch := range dispatchers {
+		if err := dispatch(r.Context()); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func detectChannel(result json.RawMessage, channel Channel) (Channel, error) {
+	if channel != "" {
+		return channel, nil
+	}
+
+	var probe channelProbe
+	if err := json.Unmarshal(result, &probe); err != nil {
+		return "", err
+	}
+	switch {
+	case probe.Channel != "":
+		return Channel(strings.ToUpper(probe.Channel)), nil
+	case len(probe.SMSCount) > 0:
+		return ChannelSMS, nil
+	case len(probe.MMSCount) > 0:
+		return ChannelMMS, nil
+	default:
+		return "", errUnknownChannel
+	}
+}
+
+// dispatcher parses a report of the given channel, returning a function passing it to the channel's callback, or
+// nil if the channel has no callback.
+//
+//nolint:cyclop // One case per channel.
+func (h *DeliveryReportHandler) dispatcher(
+	channel Channel,
+	result json.RawMessage,
+) (func(ctx context.Context) error, error) {
+	var dispatch func(ctx context.Context) error
+	var err error
+	switch {
+	case channel == ChannelSMS && h.OnSMS != nil:
+		var report SMSDeliveryReport
+		err = json.Unmarshal(result, &report)
+		dispatch = func(ctx context.Context) error { return h.OnSMS(ctx, report) }
+	case channel == ChannelMMS && h.OnMMS != nil:
+		var report MMSDeliveryReport
+		err = json.Unmarshal(result, &report)
+		dispatch = func(ctx context.Context) error { return h.OnMMS(ctx, report) }
+	case channel == ChannelWhatsApp && h.OnWhatsApp != nil:
+		var report WhatsAppDeliveryReport
+		err = json.Unmarshal(result, &report)
+		dispatch = func(ctx context.Context) error { return h.OnWhatsApp(ctx, report) }
+	case channel == ChannelRCS && h.OnRCS != nil:
+		var report RCSDeliveryReport
+		err = json.Unmarshal(result, &report)
+		dispatch = func(ctx context.Context) error { return h.OnRCS(ctx, report) }
+	case channel == ChannelEmail && h.OnEmail != nil:
+		var report EmailDeliveryReport
+		err = json.Unmarshal(result, &report)
+		dispatch = func(ctx context.Context) error { return h.OnEmail(ctx, report) }
+	case !knownChannels[channel]:
+		err = errUnknownChannel
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s delivery report: %w", channel, err)
+	}
+
+	return dispatch, nil
+}
+
+// invalidDispatcher returns a function passing a result which cannot be parsed to onInvalid, or nil if it is not
+// set.
+func invalidDispatcher(
+	onInvalid func(ctx context.Context, result json.RawMessage, err error) error,
+	result json.RawMessage,
+	err error,
+) func(ctx context.Context) error {
+	if onInvalid == nil {
+		return nil
+	}
+
+	return func(ctx context.Context) error { return onInvalid(ctx, result, err) }
+}
+
+// readBody reads the body of a POST request, responding with an error status and returning false when the method
+// is not POST or the body exceeds maxBodySize.
+func readBody(w http.ResponseWriter, r *http.Request, maxBodySize int64) ([]byte, bool) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return nil, false
+	}
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err == nil && int64(len(body)) > maxBodySize {
+		err = errBodyTooLarge
+	}
+	switch {
+	case errors.Is(err, errBodyTooLarge):
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return nil, false
+	case err != nil:
+		http.Error(w, fmt.Sprintf("reading body: %s", err), http.StatusBadRequest)
+		return nil, false
+	}
+
+	return body, true
+}
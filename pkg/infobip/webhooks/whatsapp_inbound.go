@@ -0,0 +1,349 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// Types of inbound WhatsApp messages.
+const (
+	WhatsAppTypeText                   = "TEXT"
+	WhatsAppTypeImage                  = "IMAGE"
+	WhatsAppTypeDocument               = "DOCUMENT"
+	WhatsAppTypeAudio                  = "AUDIO"
+	WhatsAppTypeVoice                  = "VOICE"
+	WhatsAppTypeVideo                  = "VIDEO"
+	WhatsAppTypeSticker                = "STICKER"
+	WhatsAppTypeLocation               = "LOCATION"
+	WhatsAppTypeContact                = "CONTACT"
+	WhatsAppTypeButton                 = "BUTTON"
+	WhatsAppTypeInteractiveButtonReply = "INTERACTIVE_BUTTON_REPLY"
+	WhatsAppTypeInteractiveListReply   = "INTERACTIVE_LIST_REPLY"
+	WhatsAppTypeOrder                  = "ORDER"
+	WhatsAppTypeUnsupported            = "UNSUPPORTED"
+)
+
+// WhatsAppInbound holds the fields shared by all inbound WhatsApp messages.
+type WhatsAppInbound struct {
+	From            string                 `json:"from"`
+	To              string                 `json:"to"`
+	IntegrationType string                 `json:"integrationType"`
+	ReceivedAt      string                 `json:"receivedAt"`
+	MessageID       string                 `json:"messageId"`
+	PairedMessageID string                 `json:"pairedMessageId"`
+	CallbackData    string                 `json:"callbackData"`
+	Contact         WhatsAppInboundContact `json:"contact"`
+	Price           models.SMSPrice        `json:"price"`
+}
+
+type WhatsAppInboundContact struct {
+	Name string `json:"name"`
+}
+
+// WhatsAppMessageCommon holds the fields shared by the contents of all inbound WhatsApp messages.
+type WhatsAppMessageCommon struct {
+	Type string `json:"type"`
+	// Context is set when the message replies to another message.
+	Context *WhatsAppMessageContext `json:"context,omitempty"`
+}
+
+type WhatsAppMessageContext struct {
+	From string `json:"from"`
+	ID   string `json:"id"`
+}
+
+// WhatsAppMessage is an inbound WhatsApp message of any type, with its content left unparsed.
+type WhatsAppMessage struct {
+	WhatsAppInbound
+	Type    string          `json:"-"`
+	Message json.RawMessage `json:"message"`
+}
+
+type WhatsAppTextContent struct {
+	WhatsAppMessageCommon
+	Text string `json:"text"`
+}
+
+type WhatsAppText struct {
+	WhatsAppInbound
+	Message WhatsAppTextContent `json:"message"`
+}
+
+type WhatsAppMediaContent struct {
+	WhatsAppMessageCommon
+	Caption string `json:"caption"`
+	// URL points to the media file, which can be downloaded with the credentials of the client.
+	URL string `json:"url"`
+}
+
+type WhatsAppImage struct {
+	WhatsAppInbound
+	Message WhatsAppMediaContent `json:"message"`
+}
+
+type WhatsAppDocument struct {
+	WhatsAppInbound
+	Message WhatsAppMediaContent `json:"message"`
+}
+
+// WhatsAppAudio is an audio file or a voice note, depending on the type of its message.
+type WhatsAppAudio struct {
+	WhatsAppInbound
+	Message WhatsAppMediaContent `json:"message"`
+}
+
+type WhatsAppVideo struct {
+	WhatsAppInbound
+	Message WhatsAppMediaContent `json:"message"`
+}
+
+type WhatsAppSticker struct {
+	WhatsAppInbound
+	Message WhatsAppMediaContent `json:"message"`
+}
+
+type WhatsAppLocationContent struct {
+	WhatsAppMessageCommon
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+	Name      string  `json:"name"`
+	Address   string  `json:"address"`
+	URL       string  `json:"url"`
+}
+
+type WhatsAppLocation struct {
+	WhatsAppInbound
+	Message WhatsAppLocationContent `json:"message"`
+}
+
+type WhatsAppContactContent struct {
+	WhatsAppMessageCommon
+	Contacts []models.Contact `json:"contacts"`
+}
+
+type WhatsAppContact struct {
+	WhatsAppInbound
+	Message WhatsAppContactContent `json:"message"`
+}
+
+// WhatsAppButtonContent is sent when a quick reply button of a template message is tapped.
+type WhatsAppButtonContent struct {
+	WhatsAppMessageCommon
+	Text    string `json:"text"`
+	Payload string `json:"payload"`
+}
+
+type WhatsAppButton struct {
+	WhatsAppInbound
+	Message WhatsAppButtonContent `json:"message"`
+}
+
+// WhatsAppButtonReplyContent is sent when a button of an interactive buttons message is tapped. ID is the one set
+// in the InteractiveButton.
+type WhatsAppButtonReplyContent struct {
+	WhatsAppMessageCommon
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type WhatsAppButtonReply struct {
+	WhatsAppInbound
+	Message WhatsAppButtonReplyContent `json:"message"`
+}
+
+// WhatsAppListReplyContent is sent when a row of an interactive list message is selected. ID is the one set in
+// the SectionRow.
+type WhatsAppListReplyContent struct {
+	WhatsAppMessageCommon
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type WhatsAppListReply struct {
+	WhatsAppInbound
+	Message WhatsAppListReplyContent `json:"message"`
+}
+
+// WhatsAppOrderContent is sent when products of a product or multi-product message are ordered.
+type WhatsAppOrderContent struct {
+	WhatsAppMessageCommon
+	CatalogID    string                 `json:"catalogId"`
+	Text         string                 `json:"text"`
+	ProductItems []WhatsAppOrderProduct `json:"productItems"`
+}
+
+type WhatsAppOrderProduct struct {
+	ProductRetailerID string  `json:"productRetailerId"`
+	Quantity          int     `json:"quantity"`
+	ItemPrice         float64 `json:"itemPrice"`
+	Currency          string  `json:"currency"`
+}
+
+type WhatsAppOrder struct {
+	WhatsAppInbound
+	Message WhatsAppOrderContent `json:"message"`
+}
+
+// WhatsAppUnsupported is a message whose type is not supported by the WhatsApp Business API.
+type WhatsAppUnsupported struct {
+	WhatsAppInbound
+	Message WhatsAppMessageCommon `json:"message"`
+}
+
+var errMissingMessage = errors.New("missing message")
+
+// WhatsAppInboundHandler receives inbound WhatsApp messages. Messages are dispatched one by one, in the order of
+// the payload, to the callback of their type. Messages of a type without a callback, including types unknown to
+// the SDK, are passed to Fallback, and ignored if it is not set either. Results which cannot be parsed, e.g.
+// without a message, are passed to OnInvalid instead, so that they do not prevent the other messages of the
+// payload from being dispatched.
+type WhatsAppInboundHandler struct {
+	// MaxBodySize limits the size of request bodies, in bytes. Defaults to DefaultMaxBodySize.
+	MaxBodySize int64
+
+	OnText        func(ctx context.Context, msg WhatsAppText) error
+	OnImage       func(ctx context.Context, msg WhatsAppImage) error
+	OnDocument    func(ctx context.Context, msg WhatsAppDocument) error
+	OnAudio       func(ctx context.Context, msg WhatsAppAudio) error
+	OnVideo       func(ctx context.Context, msg WhatsAppVideo) error
+	OnSticker     func(ctx context.Context, msg WhatsAppSticker) error
+	OnLocation    func(ctx context.Context, msg WhatsAppLocation) error
+	OnContact     func(ctx context.Context, msg WhatsAppContact) error
+	OnButton      func(ctx context.Context, msg WhatsAppButton) error
+	OnButtonReply func(ctx context.Context, msg WhatsAppButtonReply) error
+	OnListReply   func(ctx context.Context, msg WhatsAppListReply) error
+	OnOrder       func(ctx context.Context, msg WhatsAppOrder) error
+	OnUnsupported func(ctx context.Context, msg WhatsAppUnsupported) error
+	Fallback      func(ctx context.Context, msg WhatsAppMessage) error
+	// OnInvalid receives the raw results which cannot be parsed, along with the parsing error. They are ignored
+	// if it is not set.
+	OnInvalid func(ctx context.Context, result json.RawMessage, err error) error
+}
+
+type whatsAppInboundPayload struct {
+	Results             []json.RawMessage `json:"results"`
+	MessageCount        int               `json:"messageCount"`
+	PendingMessageCount int               `json:"pendingMessageCount"`
+}
+
+// ServeHTTP implements http.Handler.
+func (h *WhatsAppInboundHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r, h.MaxBodySize)
+	if !ok {
+		return
+	}
+
+	var payload whatsAppInboundPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, fmt.Sprintf("invalid inbound messages: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	dispatchers := make([]func(ctx context.Context) error, 0, len(payload.Results))
+	for _, result := range payload.Results {
+		dispatch, err := h.dispatcher(result)
+		if err != nil {
+			dispatch = invalidDispatcher(h.OnInvalid, result, err)
+		}
+		if dispatch != nil {
+			dispatchers = append(dispatchers, dispatch)
+		}
+	}
+
+	for _, dispatch := range dispatchers {
+		if err := dispatch(r.Context()); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// dispatcher parses a message, returning a function passing it to the callback of its type, or nil if there is
+// no callback to pass it to.
+//
+//nolint:cyclop,funlen,gocyclo // One case per message type.
+func (h *WhatsAppInboundHandler) dispatcher(result json.RawMessage) (func(ctx context.Context) error, error) {
+	var msg WhatsAppMessage
+	if err := json.Unmarshal(result, &msg); err != nil {
+		return nil, err
+	}
+	if len(msg.Message) == 0 || string(msg.Message) == "null" {
+		return nil, errMissingMessage
+	}
+	var common WhatsAppMessageCommon
+	if err := json.Unmarshal(msg.Message, &common); err != nil {
+		return nil, err
+	}
+	msg.Type = common.Type
+
+	var dispatch func(ctx context.Context) error
+	var err error
+	switch {
+	case msg.Type == WhatsAppTypeText && h.OnText != nil:
+		var text WhatsAppText
+		err = json.Unmarshal(result, &text)
+		dispatch = func(ctx context.Context) error { return h.OnText(ctx, text) }
+	case msg.Type == WhatsAppTypeImage && h.OnImage != nil:
+		var image WhatsAppImage
+		err = json.Unmarshal(result, &image)
+		dispatch = func(ctx context.Context) error { return h.OnImage(ctx, image) }
+	case msg.Type == WhatsAppTypeDocument && h.OnDocument != nil:
+		var document WhatsAppDocument
+		err = json.Unmarshal(result, &document)
+		dispatch = func(ctx context.Context) error { return h.OnDocument(ctx, document) }
+	case (msg.Type == WhatsAppTypeAudio || msg.Type == WhatsAppTypeVoice) && h.OnAudio != nil:
+		var audio WhatsAppAudio
+		err = json.Unmarshal(result, &audio)
+		dispatch = func(ctx context.Context) error { return h.OnAudio(ctx, audio) }
+	case msg.Type == WhatsAppTypeVideo && h.OnVideo != nil:
+		var video WhatsAppVideo
+		err = json.Unmarshal(result, &video)
+		dispatch = func(ctx context.Context) error { return h.OnVideo(ctx, video) }
+	case msg.Type == WhatsAppTypeSticker && h.OnSticker != nil:
+		var sticker WhatsAppSticker
+		err = json.Unmarshal(result, &sticker)
+		dispatch = func(ctx context.Context) error { return h.OnSticker(ctx, sticker) }
+	case msg.Type == WhatsAppTypeLocation && h.OnLocation != nil:
+		var location WhatsAppLocation
+		err = json.Unmarshal(result, &location)
+		dispatch = func(ctx context.Context) error { return h.OnLocation(ctx, location) }
+	case msg.Type == WhatsAppTypeContact && h.OnContact != nil:
+		var contact WhatsAppContact
+		err = json.Unmarshal(result, &contact)
+		dispatch = func(ctx context.Context) error { return h.OnContact(ctx, contact) }
+	case msg.Type == WhatsAppTypeButton && h.OnButton != nil:
+		var button WhatsAppButton
+		err = json.Unmarshal(result, &button)
+		dispatch = func(ctx context.Context) error { return h.OnButton(ctx, button) }
+	case msg.Type == WhatsAppTypeInteractiveButtonReply && h.OnButtonReply != nil:
+		var reply WhatsAppButtonReply
+		err = json.Unmarshal(result, &reply)
+		dispatch = func(ctx context.Context) error { return h.OnButtonReply(ctx, reply) }
+	case msg.Type == WhatsAppTypeInteractiveListReply && h.OnListReply != nil:
+		var reply WhatsAppListReply
+		err = json.Unmarshal(result, &reply)
+		dispatch = func(ctx context.Context) error { return h.OnListReply(ctx, reply) }
+	case msg.Type == WhatsAppTypeOrder && h.OnOrder != nil:
+		var order WhatsAppOrder
+		err = json.Unmarshal(result, &order)
+		dispatch = func(ctx context.Context) error { return h.OnOrder(ctx, order) }
+	case msg.Type == WhatsAppTypeUnsupported && h.OnUnsupported != nil:
+		var unsupported WhatsAppUnsupported
+		err = json.Unmarshal(result, &unsupported)
+		dispatch = func(ctx context.Context) error { return h.OnUnsupported(ctx, unsupported) }
+	case h.Fallback != nil:
+		dispatch = func(ctx context.Context) error { return h.Fallback(ctx, msg) }
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return dispatch, nil
+}
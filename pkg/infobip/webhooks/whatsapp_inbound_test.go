@@ -0,0 +1,242 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func inboundPayload(messages ...string) string {
+	results := make([]string, 0, len(messages))
+	for i, msg := range messages {
+		results = append(results, fmt.Sprintf(`{
+			"from": "385919998888",
+			"to": "447860099299",
+			"integrationType": "WHATSAPP",
+			"receivedAt": "2019-07-19T11:23:26.000+0000",
+			"messageId": "message-%d",
+			"message": %s,
+			"contact": {"name": "Frank"},
+			"price": {"pricePerMessage": 0, "currency": "EUR"}
+		}`, i, msg))
+	}
+
+	return fmt.Sprintf(`{"results": [%s], "messageCount": %d, "pendingMessageCount": 0}`,
+		strings.Join(results, ","), len(messages))
+}
+
+func TestWhatsAppInboundHandlerDispatchesByType(t *testing.T) {
+	var received []string
+	record := func(format string, args ...interface{}) {
+		received = append(received, fmt.Sprintf(format, args...))
+	}
+	handler := WhatsAppInboundHandler{
+		OnText: func(ctx context.Context, msg WhatsAppText) error {
+			record("text %s %s %s", msg.MessageID, msg.Contact.Name, msg.Message.Text)
+			return nil
+		},
+		OnImage: func(ctx context.Context, msg WhatsAppImage) error {
+			record("image %s %s", msg.Message.Caption, msg.Message.URL)
+			return nil
+		},
+		OnDocument: func(ctx context.Context, msg WhatsAppDocument) error {
+			record("document %s", msg.Message.URL)
+			return nil
+		},
+		OnAudio: func(ctx context.Context, msg WhatsAppAudio) error {
+			record("audio %s %s", msg.Message.Type, msg.Message.URL)
+			return nil
+		},
+		OnVideo: func(ctx context.Context, msg WhatsAppVideo) error {
+			record("video %s", msg.Message.URL)
+			return nil
+		},
+		OnSticker: func(ctx context.Context, msg WhatsAppSticker) error {
+			record("sticker %s", msg.Message.URL)
+			return nil
+		},
+		OnLocation: func(ctx context.Context, msg WhatsAppLocation) error {
+			record("location %v %v %s", msg.Message.Latitude, msg.Message.Longitude, msg.Message.Name)
+			return nil
+		},
+		OnContact: func(ctx context.Context, msg WhatsAppContact) error {
+			record("contact %s %s", msg.Message.Contacts[0].Name.FirstName, msg.Message.Contacts[0].Phones[0].Phone)
+			return nil
+		},
+		OnButton: func(ctx context.Context, msg WhatsAppButton) error {
+			record("button %s %s", msg.Message.Text, msg.Message.Payload)
+			return nil
+		},
+		OnButtonReply: func(ctx context.Context, msg WhatsAppButtonReply) error {
+			record("button reply %s %s %s", msg.Message.ID, msg.Message.Title, msg.Message.Context.ID)
+			return nil
+		},
+		OnListReply: func(ctx context.Context, msg WhatsAppListReply) error {
+			record("list reply %s %s %s", msg.Message.ID, msg.Message.Title, msg.Message.Description)
+			return nil
+		},
+		OnOrder: func(ctx context.Context, msg WhatsAppOrder) error {
+			item := msg.Message.ProductItems[0]
+			record("order %s %s %d %v %s", msg.Message.CatalogID, item.ProductRetailerID, item.Quantity, item.ItemPrice,
+				item.Currency)
+			return nil
+		},
+		OnUnsupported: func(ctx context.Context, msg WhatsAppUnsupported) error {
+			record("unsupported %s", msg.MessageID)
+			return nil
+		},
+	}
+
+	resp := postReports(&handler, http.MethodPost, inboundPayload(
+		`{"type": "TEXT", "text": "Hello"}`,
+		`{"type": "IMAGE", "caption": "Look", "url": "https://example.com/image"}`,
+		`{"type": "DOCUMENT", "url": "https://example.com/document"}`,
+		`{"type": "VOICE", "url": "https://example.com/voice"}`,
+		`{"type": "VIDEO", "url": "https://example.com/video"}`,
+		`{"type": "STICKER", "url": "https://example.com/sticker"}`,
+		`{"type": "LOCATION", "latitude": 44.9, "longitude": 13.8, "name": "Pula"}`,
+		`{"type": "CONTACT", "contacts": [{"name": {"firstName": "John"}, "phones": [{"phone": "+385911234567"}]}]}`,
+		`{"type": "BUTTON", "text": "Yes", "payload": "confirm"}`,
+		`{"type": "INTERACTIVE_BUTTON_REPLY", "id": "1", "title": "Yes", "context": {"from": "447860099299", "id": "x"}}`,
+		`{"type": "INTERACTIVE_LIST_REPLY", "id": "row-1", "title": "Row", "description": "First row"}`,
+		`{"type": "ORDER", "catalogId": "c", "productItems": [{"productRetailerId": "p", "quantity": 2,
+			"itemPrice": 9.5, "currency": "EUR"}]}`,
+		`{"type": "UNSUPPORTED"}`,
+	))
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.Equal(t, []string{
+		"text message-0 Frank Hello",
+		"image Look https://example.com/image",
+		"document https://example.com/document",
+		"audio VOICE https://example.com/voice",
+		"video https://example.com/video",
+		"sticker https://example.com/sticker",
+		"location 44.9 13.8 Pula",
+		"contact John +385911234567",
+		"button Yes confirm",
+		"button reply 1 Yes x",
+		"list reply row-1 Row First row",
+		"order c p 2 9.5 EUR",
+		"unsupported message-12",
+	}, received)
+}
+
+func TestWhatsAppInboundHandlerFallback(t *testing.T) {
+	var texts []WhatsAppText
+	var fallback []WhatsAppMessage
+	handler := WhatsAppInboundHandler{
+		OnText: func(ctx context.Context, msg WhatsAppText) error {
+			texts = append(texts, msg)
+			return nil
+		},
+		Fallback: func(ctx context.Context, msg WhatsAppMessage) error {
+			fallback = append(fallback, msg)
+			return nil
+		},
+	}
+
+	resp := postReports(&handler, http.MethodPost, inboundPayload(
+		`{"type": "TEXT", "text": "Hello"}`,
+		`{"type": "IMAGE", "url": "https://example.com/image"}`,
+		`{"type": "REACTION", "emoji": "👍"}`,
+	))
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	require.Len(t, texts, 1)
+	require.Len(t, fallback, 2)
+	assert.Equal(t, WhatsAppTypeImage, fallback[0].Type)
+	assert.Equal(t, "REACTION", fallback[1].Type)
+	assert.Equal(t, "message-2", fallback[1].MessageID)
+	assert.JSONEq(t, `{"type": "REACTION", "emoji": "👍"}`, string(fallback[1].Message))
+}
+
+func TestWhatsAppInboundHandlerErrors(t *testing.T) {
+	handler := WhatsAppInboundHandler{
+		MaxBodySize: 2048,
+		OnText: func(ctx context.Context, msg WhatsAppText) error {
+			return assert.AnError
+		},
+	}
+
+	tests := []struct {
+		scenario       string
+		method         string
+		body           string
+		expectedStatus int
+	}{
+		{scenario: "wrong method", method: http.MethodPut, expectedStatus: http.StatusMethodNotAllowed},
+		{
+			scenario:       "body too large",
+			method:         http.MethodPost,
+			body:           inboundPayload(`{"type": "TEXT", "text": "` + strings.Repeat("a", 2048) + `"}`),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{scenario: "invalid JSON", method: http.MethodPost, body: `[]`, expectedStatus: http.StatusBadRequest},
+		{
+			scenario:       "ignored invalid message",
+			method:         http.MethodPost,
+			body:           inboundPayload(`"text"`),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			scenario:       "callback error",
+			method:         http.MethodPost,
+			body:           inboundPayload(`{"type": "TEXT", "text": "Hello"}`),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			scenario:       "ignored message",
+			method:         http.MethodPost,
+			body:           inboundPayload(`{"type": "STICKER", "url": "https://example.com/sticker"}`),
+			expectedStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			resp := postReports(&handler, tc.method, tc.body)
+			assert.Equal(t, tc.expectedStatus, resp.Code)
+		})
+	}
+}
+
+func TestWhatsAppInboundHandlerInvalidMessages(t *testing.T) {
+	var received []string
+	handler := WhatsAppInboundHandler{
+		OnText: func(ctx context.Context, msg WhatsAppText) error {
+			received = append(received, "text "+msg.MessageID)
+			return nil
+		},
+		OnInvalid: func(ctx context.Context, result json.RawMessage, err error) error {
+			received = append(received, "invalid "+err.Error())
+			return nil
+		},
+	}
+
+	resp := postReports(&handler, http.MethodPost, inboundPayload(
+		`{"type": "TEXT", "text": "Hello"}`,
+		`null`,
+		`"text"`,
+		`{"type": "TEXT", "text": "World"}`,
+	))
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	require.Len(t, received, 4)
+	assert.Equal(t, "text message-0", received[0])
+	assert.Equal(t, "invalid missing message", received[1])
+	assert.Contains(t, received[2], "invalid json: cannot unmarshal string")
+	assert.Equal(t, "text message-3", received[3])
+
+	handler.OnInvalid = func(ctx context.Context, result json.RawMessage, err error) error {
+		return assert.AnError
+	}
+	resp = postReports(&handler, http.MethodPost, inboundPayload(`null`))
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
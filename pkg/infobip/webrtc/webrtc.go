@@ -63,6 +63,8 @@ func (wrtc *Channel) SaveApplication(
 	ctx context.Context,
 	application models.WebRTCApplication,
 ) (resp models.SaveWebRTCApplicationResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WebRTC.SaveApplication", saveApplicationPath)
+
 	respDetails, err = wrtc.ReqHandler.PostJSONReq(ctx, &application, &resp, saveApplicationPath)
 	return resp, respDetails, err
 }
@@ -70,6 +72,8 @@ func (wrtc *Channel) SaveApplication(
 func (wrtc *Channel) GetApplications(
 	ctx context.Context,
 ) (resp models.GetWebRTCApplicationsResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WebRTC.GetApplications", getApplicationsPath)
+
 	respDetails, err = wrtc.ReqHandler.GetRequest(ctx, &resp, getApplicationsPath, nil)
 	return resp, respDetails, err
 }
@@ -78,6 +82,8 @@ func (wrtc *Channel) GetApplication(
 	ctx context.Context,
 	applicationID string,
 ) (resp models.GetWebRTCApplicationResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WebRTC.GetApplication", "webrtc/1/applications/{applicationId}")
+
 	respDetails, err = wrtc.ReqHandler.GetRequest(
 		ctx, &resp, fmt.Sprint(getApplicationPath, "/", applicationID), nil)
 	return resp, respDetails, err
@@ -88,6 +94,8 @@ func (wrtc *Channel) UpdateApplication(
 	applicationID string,
 	application models.WebRTCApplication,
 ) (resp models.UpdateWebRTCApplicationResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WebRTC.UpdateApplication", "webrtc/1/applications/{applicationId}")
+
 	respDetails, err = wrtc.ReqHandler.PutJSONReq(
 		ctx, &application, &resp, fmt.Sprint(updateApplicationPath, "/", applicationID), nil)
 	return resp, respDetails, err
@@ -97,6 +105,8 @@ func (wrtc *Channel) DeleteApplication(
 	ctx context.Context,
 	applicationID string,
 ) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WebRTC.DeleteApplication", "webrtc/1/applications/{applicationId}")
+
 	respDetails, err = wrtc.ReqHandler.DeleteRequest(ctx, fmt.Sprint(deleteApplicationPath, "/", applicationID), nil)
 	return respDetails, err
 }
@@ -105,6 +115,8 @@ func (wrtc *Channel) GenerateToken(
 	ctx context.Context,
 	req models.GenerateWebRTCTokenRequest,
 ) (resp models.GenerateWebRTCTokenResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WebRTC.GenerateToken", generateTokenPath)
+
 	respDetails, err = wrtc.ReqHandler.PostJSONReq(ctx, &req, &resp, generateTokenPath)
 	return resp, respDetails, err
 }
@@ -0,0 +1,146 @@
+package whatsapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+// ErrUnsupportedBulkMsg is returned in the result of a message passed to SendBulk which is not a WhatsApp message.
+var ErrUnsupportedBulkMsg = errors.New("message type cannot be sent in bulk")
+
+// BulkOptions configures how SendBulk sends messages.
+type BulkOptions struct {
+	// Workers is the number of messages sent concurrently. Values below 1 are treated as 1.
+	Workers int
+	// RequestsPerSecond limits the rate at which messages are sent, all workers included. Rate limits configured
+	// on the client still apply. Values below or equal to 0 disable this limit.
+	RequestsPerSecond float64
+	// Burst is the maximum number of messages which can be sent at once. Values below 1 are treated as 1.
+	Burst int
+}
+
+// BulkResult is the result of sending a single message of a bulk.
+type BulkResult struct {
+	Response models.SendWAMsgResponse
+	// TemplateResponse is the response of template messages, which are sent to the template endpoint. Response
+	// holds its message when there is a single one.
+	TemplateResponse models.BulkWAMsgResponse
+	ResponseDetails  models.ResponseDetails
+	Err              error
+}
+
+// BulkResponse holds the results of SendBulk, in the order of the messages.
+type BulkResponse struct {
+	Results []BulkResult
+}
+
+// Failed returns the number of messages which failed with an error or a non-2xx response.
+func (r BulkResponse) Failed() int {
+	failed := 0
+	for _, result := range r.Results {
+		status := result.ResponseDetails.HTTPResponse.StatusCode
+		if result.Err != nil || status < http.StatusOK || status >= http.StatusMultipleChoices {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+// SendBulk sends WhatsApp messages of any type, e.g. *models.WATextMsg, *models.WAImageMsg or *models.TemplateMsg,
+// concurrently. The WhatsApp API has no bulk endpoint for free-form messages, so that each message is validated and
+// sent with its own request, and a failure only affects its own result. Template messages, either a single
+// *models.TemplateMsg or a *models.WATemplateMsgs, are sent as SendTemplate sends them. Messages not handed to a
+// worker before ctx is done are not sent, and their result holds the context's error.
+func (wap *Channel) SendBulk(ctx context.Context, msgs []models.Validatable, opts BulkOptions) BulkResponse {
+	results := make([]BulkResult, len(msgs))
+	limiter := internal.NewRateLimiter(internal.RateLimit{RequestsPerSecond: opts.RequestsPerSecond, Burst: opts.Burst})
+	send := func(index int) {
+		results[index] = wap.sendBulkMsg(ctx, limiter, msgs[index])
+	}
+	fail := func(index int, err error) {
+		results[index].Err = err
+	}
+
+	internal.RunBulk(ctx, len(msgs), opts.Workers, send, fail)
+	return BulkResponse{Results: results}
+}
+
+func (wap *Channel) sendBulkMsg(
+	ctx context.Context,
+	limiter *internal.RateLimiter,
+	msg models.Validatable,
+) (result BulkResult) {
+	reqPath, err := bulkMsgPath(msg)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if err = limiter.Wait(ctx, reqPath); err != nil {
+		result.Err = err
+		return result
+	}
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendBulk", reqPath)
+
+	if templateMsg, ok := msg.(*models.TemplateMsg); ok {
+		msg = &models.WATemplateMsgs{Messages: []models.TemplateMsg{*templateMsg}}
+	}
+	if _, ok := msg.(*models.WATemplateMsgs); !ok {
+		result.ResponseDetails, result.Err = wap.ReqHandler.PostJSONReq(ctx, msg, &result.Response, reqPath)
+		return result
+	}
+
+	result.ResponseDetails, result.Err = wap.ReqHandler.PostJSONReq(ctx, msg, &result.TemplateResponse, reqPath)
+	if len(result.TemplateResponse.Messages) == 1 {
+		result.Response = result.TemplateResponse.Messages[0]
+	}
+	return result
+}
+
+func bulkMsgPath(msg models.Validatable) (string, error) {
+	switch msg.(type) {
+	case *models.TemplateMsg, *models.WATemplateMsgs:
+		return sendTemplateMessagesPath, nil
+	case *models.WATextMsg:
+		return sendMessagePath, nil
+	case *models.WADocumentMsg:
+		return sendDocumentPath, nil
+	case *models.WAImageMsg:
+		return sendImagePath, nil
+	case *models.WAAudioMsg:
+		return sendAudioPath, nil
+	case *models.WAVideoMsg:
+		return sendVideoPath, nil
+	case *models.WAStickerMsg:
+		return sendStickerPath, nil
+	case *models.WALocationMsg:
+		return sendLocationPath, nil
+	case *models.WAContactMsg:
+		return sendContactPath, nil
+	case *models.WAInteractiveButtonsMsg:
+		return sendInteractiveButtonsPath, nil
+	case *models.WAInteractiveListMsg:
+		return sendInteractiveListPath, nil
+	case *models.WAInteractiveProductMsg:
+		return sendInteractiveProductPath, nil
+	case *models.WAInteractiveMultiproductMsg:
+		return sendInteractiveMultiproductPath, nil
+	case *models.WAInteractiveFlowMsg:
+		return sendInteractiveFlowPath, nil
+	case *models.WAInteractiveURLButtonMsg:
+		return sendInteractiveURLButtonPath, nil
+	case *models.WAInteractiveLocationRequestMsg:
+		return sendInteractiveLocationReqPath, nil
+	case *models.WAInteractiveOrderDetailsMsg:
+		return sendInteractiveOrderDetailsPath, nil
+	case *models.WAInteractiveOrderStatusMsg:
+		return sendInteractiveOrderStatusPath, nil
+	default:
+		return "", fmt.Errorf("%w: %T", ErrUnsupportedBulkMsg, msg)
+	}
+}
@@ -0,0 +1,225 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bulkTextMsg(to string) *models.WATextMsg {
+	return &models.WATextMsg{
+		MsgCommon: models.MsgCommon{From: "111111111111", To: to},
+		Content:   models.TextContent{Text: "hello world"},
+	}
+}
+
+func TestSendBulk(t *testing.T) {
+	apiKey := "secret"
+	var mu sync.Mutex
+	paths := map[string]int{}
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("App %s", apiKey), r.Header.Get("Authorization"))
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.Nil(t, servErr)
+		var msg models.MsgCommon
+		servErr = json.Unmarshal(parsedBody, &msg)
+		assert.Nil(t, servErr)
+
+		mu.Lock()
+		paths[strings.TrimPrefix(r.URL.Path, "/")]++
+		mu.Unlock()
+		if msg.To == "333333333333" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, servErr = w.Write([]byte(`{"requestError": {"serviceException": {"messageId": "BAD_REQUEST"}}}`))
+			assert.Nil(t, servErr)
+			return
+		}
+		_, servErr = w.Write([]byte(fmt.Sprintf(`{"to": %q, "messageCount": 1, "messageId": "id-%s"}`, msg.To, msg.To)))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	msgs := []models.Validatable{
+		bulkTextMsg("222222222222"),
+		&models.WAImageMsg{
+			MsgCommon: models.MsgCommon{From: "111111111111", To: "222222222223"},
+			Content:   models.ImageContent{MediaURL: "https://myurl.com/image.png"},
+		},
+		bulkTextMsg("333333333333"),
+		&models.WATextMsg{MsgCommon: models.GenerateTestMsgCommon()},
+		&models.SendSMSRequest{},
+		bulkTextMsg("222222222224"),
+	}
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL, APIKey: apiKey}}
+
+	resp := whatsApp.SendBulk(context.Background(), msgs, BulkOptions{Workers: 3})
+
+	require.Len(t, resp.Results, len(msgs))
+	for _, i := range []int{0, 1, 5} {
+		require.NoError(t, resp.Results[i].Err)
+		assert.Equal(t, http.StatusOK, resp.Results[i].ResponseDetails.HTTPResponse.StatusCode)
+		assert.Equal(t, "id-"+resp.Results[i].Response.To, resp.Results[i].Response.MessageID)
+	}
+	assert.Equal(t, "222222222222", resp.Results[0].Response.To)
+	assert.Equal(t, "222222222223", resp.Results[1].Response.To)
+	assert.Equal(t, "222222222224", resp.Results[5].Response.To)
+
+	require.NoError(t, resp.Results[2].Err)
+	assert.Equal(t, http.StatusBadRequest, resp.Results[2].ResponseDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "BAD_REQUEST", resp.Results[2].ResponseDetails.ErrorResponse.RequestError.ServiceException.MessageID)
+	assert.IsType(t, validator.ValidationErrors{}, resp.Results[3].Err)
+	assert.ErrorIs(t, resp.Results[4].Err, ErrUnsupportedBulkMsg)
+	assert.Equal(t, 3, resp.Failed())
+
+	assert.Equal(t, map[string]int{sendMessagePath: 3, sendImagePath: 1}, paths)
+}
+
+func bulkTemplateMsg(to string) models.TemplateMsg {
+	return models.TemplateMsg{
+		MsgCommon: models.MsgCommon{From: "111111111111", To: to},
+		Content: models.TemplateMsgContent{
+			TemplateName: "template_name",
+			TemplateData: models.TemplateData{Body: models.TemplateBody{Placeholders: []string{}}},
+			Language:     "en_GB",
+		},
+	}
+}
+
+func TestSendBulkTemplates(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, sendTemplateMessagesPath))
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.Nil(t, servErr)
+		var msgs models.WATemplateMsgs
+		servErr = json.Unmarshal(parsedBody, &msgs)
+		assert.Nil(t, servErr)
+
+		resp := models.BulkWAMsgResponse{BulkID: "bulk-" + msgs.Messages[0].To}
+		for _, msg := range msgs.Messages {
+			resp.Messages = append(resp.Messages, models.SendWAMsgResponse{To: msg.To, MessageID: "id-" + msg.To})
+		}
+		servErr = json.NewEncoder(w).Encode(resp)
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	single := bulkTemplateMsg("222222222222")
+	msgs := []models.Validatable{
+		&single,
+		&models.WATemplateMsgs{Messages: []models.TemplateMsg{
+			bulkTemplateMsg("222222222223"),
+			bulkTemplateMsg("222222222224"),
+		}},
+		&models.TemplateMsg{MsgCommon: models.GenerateTestMsgCommon()},
+	}
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+
+	resp := whatsApp.SendBulk(context.Background(), msgs, BulkOptions{Workers: 2})
+
+	require.Len(t, resp.Results, len(msgs))
+	require.NoError(t, resp.Results[0].Err)
+	assert.Equal(t, "id-222222222222", resp.Results[0].Response.MessageID)
+	assert.Equal(t, "bulk-222222222222", resp.Results[0].TemplateResponse.BulkID)
+	require.NoError(t, resp.Results[1].Err)
+	assert.Equal(t, models.SendWAMsgResponse{}, resp.Results[1].Response)
+	assert.Equal(t, "bulk-222222222223", resp.Results[1].TemplateResponse.BulkID)
+	require.Len(t, resp.Results[1].TemplateResponse.Messages, 2)
+	assert.Equal(t, "id-222222222224", resp.Results[1].TemplateResponse.Messages[1].MessageID)
+	assert.IsType(t, validator.ValidationErrors{}, resp.Results[2].Err)
+	assert.Equal(t, 1, resp.Failed())
+}
+
+func TestSendBulkWorkers(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		_, servErr := w.Write([]byte(`{"messageCount": 1}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	msgs := make([]models.Validatable, 0, 10)
+	for i := 0; i < 10; i++ {
+		msgs = append(msgs, bulkTextMsg("222222222222"))
+	}
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+
+	resp := whatsApp.SendBulk(context.Background(), msgs, BulkOptions{Workers: 4})
+
+	assert.Equal(t, 0, resp.Failed())
+	assert.Equal(t, 4, maxInFlight)
+}
+
+func TestSendBulkRateLimit(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, servErr := w.Write([]byte(`{"messageCount": 1}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	msgs := make([]models.Validatable, 0, 5)
+	for i := 0; i < 5; i++ {
+		msgs = append(msgs, bulkTextMsg("222222222222"))
+	}
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+
+	start := time.Now()
+	resp := whatsApp.SendBulk(context.Background(), msgs, BulkOptions{Workers: 5, RequestsPerSecond: 20, Burst: 1})
+
+	assert.Equal(t, 0, resp.Failed())
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestSendBulkContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sent := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		if sent == 2 {
+			cancel()
+		}
+		_, servErr := w.Write([]byte(`{"messageCount": 1}`))
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+
+	msgs := make([]models.Validatable, 0, 5)
+	for i := 0; i < 5; i++ {
+		msgs = append(msgs, bulkTextMsg("222222222222"))
+	}
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+
+	resp := whatsApp.SendBulk(ctx, msgs, BulkOptions{Workers: 1})
+
+	require.Len(t, resp.Results, 5)
+	require.NoError(t, resp.Results[0].Err)
+	assert.Equal(t, 2, sent)
+	for _, result := range resp.Results[2:] {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+	assert.GreaterOrEqual(t, resp.Failed(), 3)
+}
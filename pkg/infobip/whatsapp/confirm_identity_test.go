@@ -0,0 +1,61 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmIdentityValidReq(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	userNumber := "222222222222"
+	req := models.ConfirmWAIdentityRequest{Hash: "some-hash"}
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(identityPath, sender, userNumber)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.NoError(t, servErr)
+		var receivedReq models.ConfirmWAIdentityRequest
+		servErr = json.Unmarshal(parsedBody, &receivedReq)
+		assert.NoError(t, servErr)
+		assert.Equal(t, req, receivedReq)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	respDetails, err := whatsApp.ConfirmIdentity(context.Background(), sender, userNumber, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestConfirmIdentityInvalidReq(t *testing.T) {
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}}
+	respDetails, err := whatsApp.ConfirmIdentity(
+		context.Background(), "111111111111", "222222222222", models.ConfirmWAIdentityRequest{})
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
@@ -0,0 +1,77 @@
+package whatsapp
+
+import (
+	"sync"
+	"time"
+)
+
+// CustomerServiceWindow is how long after the last message of a user free-form messages can be sent to them.
+// Outside of the window, only template messages are allowed.
+const CustomerServiceWindow = 24 * time.Hour
+
+// ConversationTracker keeps track of the last inbound message of each user, per sender, to tell whether the
+// customer service window is open. It is safe for concurrent use. The zero value is ready to use.
+type ConversationTracker struct {
+	mu          sync.RWMutex
+	lastInbound map[conversation]time.Time
+}
+
+type conversation struct {
+	sender string
+	user   string
+}
+
+// RecordInbound records a message received by sender from user at receivedAt, e.g. from an inbound message
+// webhook. Messages received out of order never move the last inbound time backwards.
+func (t *ConversationTracker) RecordInbound(sender string, user string, receivedAt time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.lastInbound == nil {
+		t.lastInbound = map[conversation]time.Time{}
+	}
+	key := conversation{sender: sender, user: user}
+	if last, ok := t.lastInbound[key]; !ok || receivedAt.After(last) {
+		t.lastInbound[key] = receivedAt
+	}
+}
+
+// LastInbound returns the time of the last message received by sender from user.
+func (t *ConversationTracker) LastInbound(sender string, user string) (time.Time, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	last, ok := t.lastInbound[conversation{sender: sender, user: user}]
+	return last, ok
+}
+
+// WindowExpiresAt returns when the customer service window between sender and user closes. It returns false if
+// no message from user has been recorded.
+func (t *ConversationTracker) WindowExpiresAt(sender string, user string) (time.Time, bool) {
+	last, ok := t.LastInbound(sender, user)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return last.Add(CustomerServiceWindow), true
+}
+
+// CanSendFreeForm reports whether sender can send free-form messages, such as text or media messages, to user
+// at the given time. When it returns false, a template message has to be sent instead.
+func (t *ConversationTracker) CanSendFreeForm(sender string, user string, now time.Time) bool {
+	expiresAt, ok := t.WindowExpiresAt(sender, user)
+	return ok && now.Before(expiresAt)
+}
+
+// Prune forgets the conversations whose window is closed at the given time, to bound the memory used by
+// long-running trackers.
+func (t *ConversationTracker) Prune(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, last := range t.lastInbound {
+		if !now.Before(last.Add(CustomerServiceWindow)) {
+			delete(t.lastInbound, key)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package whatsapp
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversationTracker(t *testing.T) {
+	received := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	tracker := ConversationTracker{}
+
+	assert.False(t, tracker.CanSendFreeForm("111111111111", "222222222222", received))
+	_, ok := tracker.WindowExpiresAt("111111111111", "222222222222")
+	assert.False(t, ok)
+
+	tracker.RecordInbound("111111111111", "222222222222", received)
+	tracker.RecordInbound("111111111111", "222222222222", received.Add(-time.Hour))
+
+	last, ok := tracker.LastInbound("111111111111", "222222222222")
+	assert.True(t, ok)
+	assert.Equal(t, received, last)
+	expiresAt, ok := tracker.WindowExpiresAt("111111111111", "222222222222")
+	assert.True(t, ok)
+	assert.Equal(t, received.Add(CustomerServiceWindow), expiresAt)
+
+	assert.True(t, tracker.CanSendFreeForm("111111111111", "222222222222", received.Add(23*time.Hour)))
+	assert.False(t, tracker.CanSendFreeForm("111111111111", "222222222222", received.Add(24*time.Hour)))
+	assert.False(t, tracker.CanSendFreeForm("333333333333", "222222222222", received))
+	assert.False(t, tracker.CanSendFreeForm("111111111111", "333333333333", received))
+}
+
+func TestConversationTrackerPrune(t *testing.T) {
+	now := time.Date(2022, 3, 2, 10, 0, 0, 0, time.UTC)
+	tracker := ConversationTracker{}
+	tracker.RecordInbound("111111111111", "222222222222", now.Add(-25*time.Hour))
+	tracker.RecordInbound("111111111111", "333333333333", now.Add(-time.Hour))
+
+	tracker.Prune(now)
+
+	_, ok := tracker.LastInbound("111111111111", "222222222222")
+	assert.False(t, ok)
+	_, ok = tracker.LastInbound("111111111111", "333333333333")
+	assert.True(t, ok)
+}
+
+func TestConversationTrackerConcurrentUse(t *testing.T) {
+	now := time.Now()
+	tracker := ConversationTracker{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tracker.RecordInbound("111111111111", "222222222222", now.Add(time.Duration(i)*time.Second))
+			tracker.CanSendFreeForm("111111111111", "222222222222", now)
+			tracker.Prune(now)
+		}(i)
+	}
+	wg.Wait()
+
+	last, ok := tracker.LastInbound("111111111111", "222222222222")
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(9*time.Second), last)
+}
@@ -0,0 +1,62 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteMediaValidReq(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	req := models.DeleteWAMediaRequest{URL: "https://some.api.infobip.com/whatsapp/1/senders/111111111111/media/id"}
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(mediaPath, sender)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.NoError(t, servErr)
+		var receivedReq models.DeleteWAMediaRequest
+		servErr = json.Unmarshal(parsedBody, &receivedReq)
+		assert.NoError(t, servErr)
+		assert.Equal(t, req, receivedReq)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	respDetails, err := whatsApp.DeleteMedia(context.Background(), sender, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestDeleteMediaInvalidReq(t *testing.T) {
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}}
+	respDetails, err := whatsApp.DeleteMedia(context.Background(), "111111111111", models.DeleteWAMediaRequest{
+		URL: "not a url",
+	})
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
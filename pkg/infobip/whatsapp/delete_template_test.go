@@ -20,7 +20,7 @@ func TestDeleteWATemplateValidReq(t *testing.T) {
 	templateName := "template-name"
 	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodDelete, r.Method)
-		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(deleteTemplatePath, sender, templateName)))
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(templatePath, sender, templateName)))
 		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
 
 		w.WriteHeader(http.StatusNoContent)
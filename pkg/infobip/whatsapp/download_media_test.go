@@ -0,0 +1,139 @@
+package whatsapp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadMedia(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	mediaID := "some-media-id"
+	content := []byte("some binary content")
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(mediaFilePath, sender, mediaID)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write(content)
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	var buf bytes.Buffer
+	respDetails, err := whatsApp.DownloadMedia(context.Background(), sender, mediaID, &buf)
+
+	require.NoError(t, err)
+	assert.Equal(t, content, buf.Bytes())
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "image/jpeg", respDetails.HTTPResponse.Header.Get("Content-Type"))
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestDownloadMediaNotFound(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, servErr := w.Write([]byte(`{"requestError": {"serviceException": {"messageId": "NOT_FOUND"}}}`))
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+	var buf bytes.Buffer
+	respDetails, err := whatsApp.DownloadMedia(context.Background(), "111111111111", "some-media-id", &buf)
+
+	require.NoError(t, err)
+	assert.Empty(t, buf.Bytes())
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "NOT_FOUND", respDetails.ErrorResponse.RequestError.ServiceException.MessageID)
+}
+
+func TestDownloadMediaByURL(t *testing.T) {
+	apiKey := "some-api-key"
+	content := []byte("some binary content")
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/whatsapp/1/senders/111111111111/media/some-media-id", r.URL.Path)
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write(content)
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	var buf bytes.Buffer
+	mediaURL := serv.URL + "/whatsapp/1/senders/111111111111/media/some-media-id"
+	respDetails, err := whatsApp.DownloadMediaByURL(context.Background(), mediaURL, &buf)
+
+	require.NoError(t, err)
+	assert.Equal(t, content, buf.Bytes())
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestDownloadMediaByURLWithQuery(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/whatsapp/1/senders/111111111111/media/some-media-id", r.URL.Path)
+		assert.Equal(t, "token=a%2Fb&expires=1700000000", r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     "some-api-key",
+	}}
+	mediaURL := serv.URL + "/whatsapp/1/senders/111111111111/media/some-media-id?token=a%2Fb&expires=1700000000"
+	respDetails, err := whatsApp.DownloadMediaByURL(context.Background(), mediaURL, &bytes.Buffer{})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+}
+
+func TestDownloadMediaByForeignURL(t *testing.T) {
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    "https://some.api.infobip.com",
+		APIKey:     "some-api-key",
+	}}
+
+	tests := []string{
+		"https://attacker.example.com/whatsapp/1/senders/111111111111/media/some-media-id",
+		"http://some.api.infobip.com/whatsapp/1/senders/111111111111/media/some-media-id",
+		"https://some.api.infobip.com.example.com/media",
+		"://invalid",
+	}
+	for _, mediaURL := range tests {
+		t.Run(mediaURL, func(t *testing.T) {
+			var buf bytes.Buffer
+			respDetails, err := whatsApp.DownloadMediaByURL(context.Background(), mediaURL, &buf)
+			require.Error(t, err)
+			assert.Equal(t, models.ResponseDetails{}, respDetails)
+		})
+	}
+
+	_, err := whatsApp.DownloadMediaByURL(context.Background(), tests[0], &bytes.Buffer{})
+	assert.ErrorIs(t, err, ErrForeignMediaURL)
+}
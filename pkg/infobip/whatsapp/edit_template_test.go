@@ -0,0 +1,85 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditTemplateValidReq(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	templateID := "111"
+	template := models.TemplateEdit{
+		Category: "UTILITY",
+		Structure: models.TemplateStructure{
+			Body: &models.TemplateStructureBody{Text: "example {{1}} body"},
+			Type: "TEXT",
+		},
+	}
+	rawJSONResp := []byte(`{
+		"ID": "111",
+		"businessAccountID": 222,
+		"name": "some_template",
+		"language": "en",
+		"status": "PENDING",
+		"category": "UTILITY",
+		"structure": {"body": {"text": "example {{1}} body"}, "type": "TEXT"}
+	}`)
+	var expectedResp models.CreateWATemplateResponse
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(templatePath, sender, templateID)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.NoError(t, servErr)
+		var receivedReq models.TemplateEdit
+		servErr = json.Unmarshal(parsedBody, &receivedReq)
+		assert.NoError(t, servErr)
+		assert.Equal(t, template, receivedReq)
+
+		w.WriteHeader(http.StatusOK)
+		_, servErr = w.Write(rawJSONResp)
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	resp, respDetails, err := whatsApp.EditTemplate(context.Background(), sender, templateID, template)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, resp)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestEditTemplateInvalidReq(t *testing.T) {
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}}
+	resp, respDetails, err := whatsApp.EditTemplate(context.Background(), "111111111111", "111", models.TemplateEdit{
+		Category: "OTHER",
+	})
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.CreateWATemplateResponse{}, resp)
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
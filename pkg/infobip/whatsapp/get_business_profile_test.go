@@ -0,0 +1,54 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBusinessProfile(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	rawJSONResp := []byte(`{
+		"about": "Always open",
+		"address": "Some street 1, Zagreb",
+		"description": "Some company",
+		"email": "info@example.com",
+		"websites": ["https://www.example.com"],
+		"vertical": "RETAIL"
+	}`)
+	var expectedResp models.WABusinessProfile
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(businessProfilePath, sender)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write(rawJSONResp)
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	resp, respDetails, err := whatsApp.GetBusinessProfile(context.Background(), sender)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, resp)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
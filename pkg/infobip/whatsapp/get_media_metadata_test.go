@@ -0,0 +1,57 @@
+package whatsapp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMediaMetadata(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	mediaID := "some-media-id"
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodHead, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(mediaFilePath, sender, mediaID)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Length", "1024")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	resp, respDetails, err := whatsApp.GetMediaMetadata(context.Background(), sender, mediaID)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.WAMediaMetadata{ContentType: "application/pdf", ContentLength: 1024}, resp)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestGetMediaMetadataNotFound(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+	resp, respDetails, err := whatsApp.GetMediaMetadata(context.Background(), "111111111111", "some-media-id")
+
+	require.NoError(t, err)
+	assert.Equal(t, models.WAMediaMetadata{}, resp)
+	assert.Equal(t, http.StatusNotFound, respDetails.HTTPResponse.StatusCode)
+}
@@ -0,0 +1,58 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPaymentStatus(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	referenceID := "order-42"
+	rawJSONResp := []byte(`{
+		"referenceId": "order-42",
+		"status": "CAPTURED",
+		"currency": "INR",
+		"totalAmount": {"value": 11000, "offset": 100},
+		"transactionId": "txn-7",
+		"paymentMethod": "UPI",
+		"createdAt": "2022-03-01T10:22:13.000+0000",
+		"updatedAt": "2022-03-01T10:23:01.000+0000"
+	}`)
+	var expectedResp models.WAPaymentStatus
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(paymentPath, sender, referenceID)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write(rawJSONResp)
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	resp, respDetails, err := whatsApp.GetPaymentStatus(context.Background(), sender, referenceID)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, resp)
+	assert.Equal(t, int64(11000), resp.TotalAmount.Value)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
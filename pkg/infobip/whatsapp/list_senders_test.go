@@ -0,0 +1,58 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSenders(t *testing.T) {
+	apiKey := "some-api-key"
+	rawJSONResp := []byte(`{
+		"senders": [
+			{
+				"sender": "111111111111",
+				"displayName": "Some Company",
+				"status": "CONNECTED",
+				"qualityRating": "GREEN",
+				"messagingLimit": "TIER_10K"
+			}
+		]
+	}`)
+	var expectedResp models.GetWASendersResponse
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, sendersPath))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+		_, servErr := w.Write(rawJSONResp)
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	resp, respDetails, err := whatsApp.ListSenders(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, resp)
+	require.Len(t, resp.Senders, 1)
+	assert.Equal(t, "TIER_10K", resp.Senders[0].MessagingLimit)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
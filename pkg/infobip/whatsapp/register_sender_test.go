@@ -0,0 +1,79 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterSenderValidReq(t *testing.T) {
+	apiKey := "some-api-key"
+	req := models.RegisterWASenderRequest{
+		PhoneNumber:        "111111111111",
+		DisplayName:        "Some Company",
+		VerificationMethod: "SMS",
+	}
+	rawJSONResp := []byte(`{
+		"sender": "111111111111",
+		"displayName": "Some Company",
+		"status": "PENDING_VERIFICATION"
+	}`)
+	var expectedResp models.WASender
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, sendersPath))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.NoError(t, servErr)
+		var receivedReq models.RegisterWASenderRequest
+		servErr = json.Unmarshal(parsedBody, &receivedReq)
+		assert.NoError(t, servErr)
+		assert.Equal(t, req, receivedReq)
+
+		w.WriteHeader(http.StatusCreated)
+		_, servErr = w.Write(rawJSONResp)
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	resp, respDetails, err := whatsApp.RegisterSender(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, resp)
+	assert.Equal(t, http.StatusCreated, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestRegisterSenderInvalidReq(t *testing.T) {
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}}
+	resp, respDetails, err := whatsApp.RegisterSender(context.Background(), models.RegisterWASenderRequest{
+		PhoneNumber:        "111111111111",
+		DisplayName:        "Some Company",
+		VerificationMethod: "EMAIL",
+	})
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.WASender{}, resp)
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
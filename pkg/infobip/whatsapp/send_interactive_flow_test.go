@@ -0,0 +1,96 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInteractiveFlowValidReq(t *testing.T) {
+	apiKey := "secret"
+	msg := models.WAInteractiveFlowMsg{
+		MsgCommon: models.GenerateTestMsgCommon(),
+		Content: models.InteractiveFlowContent{
+			Body: models.InteractiveFlowBody{Text: "Book your appointment"},
+			Action: models.InteractiveFlowAction{
+				FlowMessageVersion: 3,
+				FlowID:             "1234567890",
+				CallToActionButton: "Book",
+				FlowActionPayload: &models.InteractiveFlowActionPayload{
+					Screen: "WELCOME",
+					Data:   map[string]interface{}{"name": "Ana"},
+				},
+			},
+		},
+	}
+	rawJSONResp := []byte(`{
+		"to": "441134960001",
+		"messageCount": 1,
+		"messageId": "a28dd97c-1ffb-4fcf-99f1-0b557ed381da",
+		"status": {
+			"groupId": 1,
+			"groupName": "PENDING",
+			"id": 7,
+			"name": "PENDING_ENROUTE",
+			"description": "Message sent to next instance"
+		}
+	}`)
+	var expectedResp models.SendWAMsgResponse
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, sendInteractiveFlowPath))
+		assert.Equal(t, fmt.Sprintf("App %s", apiKey), r.Header.Get("Authorization"))
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.Nil(t, servErr)
+
+		var receivedMsg models.WAInteractiveFlowMsg
+		servErr = json.Unmarshal(parsedBody, &receivedMsg)
+		assert.Nil(t, servErr)
+		assert.Equal(t, msg, receivedMsg)
+
+		_, servErr = w.Write(rawJSONResp)
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+
+	msgResp, respDetails, err := whatsApp.SendInteractiveFlow(context.Background(), msg)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, msgResp)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestInvalidInteractiveFlowMsg(t *testing.T) {
+	msg := models.WAInteractiveFlowMsg{MsgCommon: models.GenerateTestMsgCommon()}
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    "https://something.api.infobip.com",
+		APIKey:     "secret",
+	}}
+
+	msgResp, respDetails, err := whatsApp.SendInteractiveFlow(context.Background(), msg)
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.SendWAMsgResponse{}, msgResp)
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
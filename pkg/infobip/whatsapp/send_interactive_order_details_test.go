@@ -0,0 +1,104 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInteractiveOrderDetailsValidReq(t *testing.T) {
+	apiKey := "secret"
+	msg := models.WAInteractiveOrderDetailsMsg{
+		MsgCommon: models.GenerateTestMsgCommon(),
+		Content: models.InteractiveOrderDetailsContent{
+			Body: models.InteractiveOrderDetailsBody{Text: "Your order is ready for payment."},
+			Action: models.InteractiveOrderDetailsAction{
+				ReferenceID:          "order-42",
+				Type:                 "DIGITAL_GOODS",
+				PaymentConfiguration: "checkout",
+				Currency:             "INR",
+				TotalAmount:          models.InteractiveOrderAmount{Value: 11000, Offset: 100},
+				Order: models.InteractiveOrder{
+					Items: []models.InteractiveOrderItem{{
+						RetailerID: "sku-1",
+						Name:       "Gift card",
+						Amount:     models.InteractiveOrderAmount{Value: 5000, Offset: 100},
+						Quantity:   2,
+					}},
+					Subtotal: models.InteractiveOrderAmount{Value: 10000, Offset: 100},
+					Tax:      models.InteractiveOrderAmount{Value: 1000, Offset: 100},
+				},
+			},
+		},
+	}
+	rawJSONResp := []byte(`{
+		"to": "441134960001",
+		"messageCount": 1,
+		"messageId": "a28dd97c-1ffb-4fcf-99f1-0b557ed381da",
+		"status": {
+			"groupId": 1,
+			"groupName": "PENDING",
+			"id": 7,
+			"name": "PENDING_ENROUTE",
+			"description": "Message sent to next instance"
+		}
+	}`)
+	var expectedResp models.SendWAMsgResponse
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, sendInteractiveOrderDetailsPath))
+		assert.Equal(t, fmt.Sprintf("App %s", apiKey), r.Header.Get("Authorization"))
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.Nil(t, servErr)
+
+		var receivedMsg models.WAInteractiveOrderDetailsMsg
+		servErr = json.Unmarshal(parsedBody, &receivedMsg)
+		assert.Nil(t, servErr)
+		assert.Equal(t, msg, receivedMsg)
+
+		_, servErr = w.Write(rawJSONResp)
+		assert.Nil(t, servErr)
+	}))
+	defer serv.Close()
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+
+	msgResp, respDetails, err := whatsApp.SendInteractiveOrderDetails(context.Background(), msg)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, msgResp)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestInvalidInteractiveOrderDetailsMsg(t *testing.T) {
+	msg := models.WAInteractiveOrderDetailsMsg{MsgCommon: models.GenerateTestMsgCommon()}
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    "https://something.api.infobip.com",
+		APIKey:     "secret",
+	}}
+
+	msgResp, respDetails, err := whatsApp.SendInteractiveOrderDetails(context.Background(), msg)
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.SendWAMsgResponse{}, msgResp)
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
@@ -0,0 +1,286 @@
+package whatsapp
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+)
+
+var (
+	ErrTemplateNotApproved = errors.New("template is not approved")
+	ErrPlaceholderValues   = errors.New("placeholder values do not match the template")
+	ErrHeaderValues        = errors.New("header values do not match the template")
+	ErrButtonValues        = errors.New("button values do not match the template")
+)
+
+var placeholderPattern = regexp.MustCompile(`{{\s*([A-Za-z0-9_]+)\s*}}`) //nolint: gochecknoglobals // read-only
+
+// TemplateValues are the values filling a template, to send it in a message or to preview it.
+type TemplateValues struct {
+	// Body holds the values of the body placeholders, in order, e.g. {{1}} first.
+	Body []string
+	// NamedBody holds the values of the body placeholders by name, e.g. "first_name" for {{first_name}}.
+	// Positional placeholders can be given by number, e.g. "1" for {{1}}. It is used when Body is empty.
+	NamedBody map[string]string
+	// Header holds the values of the header, i.e. its placeholder, media or location. Its Type defaults to the
+	// format of the template header. Templates with a TEXT header without placeholder need no Header.
+	Header *models.TemplateMsgHeader
+	// Buttons holds the parameters of the buttons, in order: the payload of each QUICK_REPLY button, and the URL
+	// suffix of each URL button ending with a placeholder.
+	Buttons []string
+}
+
+// BuildTemplateMsg builds a message sending a template fetched with GetTemplates or GetTemplate. The values are
+// checked against the structure of the template, so that mismatches are reported before the message is sent.
+func BuildTemplateMsg(
+	template models.CreateWATemplateResponse,
+	common models.MsgCommon,
+	values TemplateValues,
+) (models.TemplateMsg, error) {
+	if template.Status != "" && template.Status != models.TemplateStatusApproved {
+		return models.TemplateMsg{}, fmt.Errorf("%w: %s is %s", ErrTemplateNotApproved, template.Name, template.Status)
+	}
+
+	placeholders, err := bodyPlaceholders(template.Structure, values)
+	if err != nil {
+		return models.TemplateMsg{}, err
+	}
+	header, err := templateMsgHeader(template.Structure.Header, values.Header)
+	if err != nil {
+		return models.TemplateMsg{}, err
+	}
+	buttons, err := templateMsgButtons(template.Structure.Buttons, values.Buttons)
+	if err != nil {
+		return models.TemplateMsg{}, err
+	}
+
+	msg := models.TemplateMsg{
+		MsgCommon: common,
+		Content: models.TemplateMsgContent{
+			TemplateName: template.Name,
+			Language:     template.Language,
+			TemplateData: models.TemplateData{
+				Body:    models.TemplateBody{Placeholders: placeholders},
+				Header:  header,
+				Buttons: buttons,
+			},
+		},
+	}
+	if err = (&models.WATemplateMsgs{Messages: []models.TemplateMsg{msg}}).Validate(); err != nil {
+		return models.TemplateMsg{}, err
+	}
+
+	return msg, nil
+}
+
+// PreviewTemplate renders a template filled with values as plain text, the way it would read on a phone: the
+// header, body, footer and buttons are separated by blank lines, and media headers are shown as [IMAGE] etc.
+func PreviewTemplate(template models.CreateWATemplateResponse, values TemplateValues) (string, error) {
+	structure := template.Structure
+	placeholders, err := bodyPlaceholders(structure, values)
+	if err != nil {
+		return "", err
+	}
+	header, err := templateMsgHeader(structure.Header, values.Header)
+	if err != nil {
+		return "", err
+	}
+	if _, err = templateMsgButtons(structure.Buttons, values.Buttons); err != nil {
+		return "", err
+	}
+
+	var sections []string
+	if structure.Header != nil {
+		sections = append(sections, previewHeader(structure.Header, header))
+	}
+	if structure.Body != nil {
+		byKey := map[string]string{}
+		for i, key := range placeholderKeys(structure.Body.Text) {
+			byKey[key] = placeholders[i]
+		}
+		sections = append(sections, fillPlaceholders(structure.Body.Text, byKey))
+	}
+	if structure.Footer != nil {
+		sections = append(sections, structure.Footer.Text)
+	}
+	if len(structure.Buttons) > 0 {
+		sections = append(sections, previewButtons(structure.Buttons, values.Buttons))
+	}
+
+	return strings.Join(sections, "\n\n"), nil
+}
+
+// placeholderKeys returns the distinct placeholders of a text. Positional placeholders are sorted by number,
+// named ones are kept in order of appearance.
+func placeholderKeys(text string) []string {
+	var keys []string
+	seen := map[string]bool{}
+	positional := true
+	for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+		key := match[1]
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+		if _, err := strconv.Atoi(key); err != nil {
+			positional = false
+		}
+	}
+	if positional {
+		sort.Slice(keys, func(i, j int) bool {
+			left, _ := strconv.Atoi(keys[i])
+			right, _ := strconv.Atoi(keys[j])
+			return left < right
+		})
+	}
+
+	return keys
+}
+
+func fillPlaceholders(text string, values map[string]string) string {
+	return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		return values[placeholderPattern.FindStringSubmatch(placeholder)[1]]
+	})
+}
+
+// fillPlaceholder fills every placeholder of a text, which is meant to have a single one, with value.
+func fillPlaceholder(text string, value string) string {
+	return placeholderPattern.ReplaceAllString(text, strings.ReplaceAll(value, "$", "$$"))
+}
+
+func bodyPlaceholders(structure models.TemplateStructure, values TemplateValues) ([]string, error) {
+	var keys []string
+	if structure.Body != nil {
+		keys = placeholderKeys(structure.Body.Text)
+	}
+
+	if len(values.Body) > 0 || len(values.NamedBody) == 0 {
+		if len(values.Body) != len(keys) {
+			return nil, fmt.Errorf("%w: the body has %d placeholders, got %d values",
+				ErrPlaceholderValues, len(keys), len(values.Body))
+		}
+		return append([]string{}, values.Body...), nil
+	}
+
+	placeholders := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value, ok := values.NamedBody[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing a value for {{%s}}", ErrPlaceholderValues, key)
+		}
+		placeholders = append(placeholders, value)
+	}
+	if len(values.NamedBody) != len(keys) {
+		return nil, fmt.Errorf("%w: the body has %d placeholders, got %d values",
+			ErrPlaceholderValues, len(keys), len(values.NamedBody))
+	}
+
+	return placeholders, nil
+}
+
+// templateMsgHeader returns the header of a message sending a template, which is nil when no values are needed.
+func templateMsgHeader( //nolint:nilnil // A nil header is omitted from messages.
+	templateHeader *models.TemplateHeader,
+	value *models.TemplateMsgHeader,
+) (*models.TemplateMsgHeader, error) {
+	if templateHeader == nil {
+		if value != nil {
+			return nil, fmt.Errorf("%w: the template has no header", ErrHeaderValues)
+		}
+		return nil, nil
+	}
+
+	if templateHeader.Format == "TEXT" && len(placeholderKeys(templateHeader.Text)) == 0 {
+		if value != nil {
+			return nil, fmt.Errorf("%w: the header has no placeholder", ErrHeaderValues)
+		}
+		return nil, nil
+	}
+	if value == nil {
+		return nil, fmt.Errorf("%w: missing values for the %s header", ErrHeaderValues, templateHeader.Format)
+	}
+
+	header := *value
+	if header.Type == "" {
+		header.Type = templateHeader.Format
+	}
+	if header.Type != templateHeader.Format {
+		return nil, fmt.Errorf("%w: the header is %s, got %s", ErrHeaderValues, templateHeader.Format, header.Type)
+	}
+
+	return &header, nil
+}
+
+// dynamicButton reports whether a button of a template takes a parameter in messages.
+func dynamicButton(button models.TemplateButton) bool {
+	switch button.Type {
+	case "QUICK_REPLY":
+		return true
+	case "URL":
+		return len(placeholderKeys(button.URL)) > 0
+	default:
+		return false
+	}
+}
+
+func templateMsgButtons(templateButtons []models.TemplateButton, values []string) ([]models.TemplateMsgButton, error) {
+	var dynamic []models.TemplateButton
+	for _, button := range templateButtons {
+		if dynamicButton(button) {
+			dynamic = append(dynamic, button)
+		}
+	}
+	if len(dynamic) != len(values) {
+		return nil, fmt.Errorf("%w: the template has %d buttons with parameters, got %d values",
+			ErrButtonValues, len(dynamic), len(values))
+	}
+
+	var buttons []models.TemplateMsgButton
+	for i, button := range dynamic {
+		buttons = append(buttons, models.TemplateMsgButton{Type: button.Type, Parameter: values[i]})
+	}
+
+	return buttons, nil
+}
+
+func previewHeader(templateHeader *models.TemplateHeader, header *models.TemplateMsgHeader) string {
+	switch {
+	case templateHeader.Format != "TEXT":
+		return fmt.Sprintf("[%s]", templateHeader.Format)
+	case header == nil:
+		return templateHeader.Text
+	default:
+		return fillPlaceholder(templateHeader.Text, header.Placeholder)
+	}
+}
+
+func previewButtons(templateButtons []models.TemplateButton, values []string) string {
+	lines := make([]string, 0, len(templateButtons))
+	next := 0
+	for _, button := range templateButtons {
+		switch button.Type {
+		case "URL":
+			url := button.URL
+			if dynamicButton(button) {
+				url = fillPlaceholder(url, values[next])
+				next++
+			}
+			lines = append(lines, fmt.Sprintf("[%s](%s)", button.Text, url))
+		case "PHONE_NUMBER":
+			lines = append(lines, fmt.Sprintf("[%s](tel:%s)", button.Text, button.PhoneNumber))
+		default:
+			if dynamicButton(button) {
+				next++
+			}
+			lines = append(lines, fmt.Sprintf("[%s]", button.Text))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
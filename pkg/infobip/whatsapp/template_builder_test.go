@@ -0,0 +1,233 @@
+package whatsapp
+
+import (
+	"testing"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTemplate() models.CreateWATemplateResponse {
+	return models.CreateWATemplateResponse{
+		ID:       "111",
+		Name:     "order_shipped",
+		Language: "en",
+		Status:   models.TemplateStatusApproved,
+		Category: "UTILITY",
+		Structure: models.TemplateStructure{
+			Header: &models.TemplateHeader{Format: "TEXT", Text: "Order {{1}}"},
+			Body:   &models.TemplateStructureBody{Text: "Hi {{1}}, your order ships on {{2}}. Thanks, {{1}}!"},
+			Footer: &models.TemplateStructureFooter{Text: "Reply STOP to opt out"},
+			Buttons: []models.TemplateButton{
+				{Type: "URL", Text: "Track", URL: "https://www.example.com/track/{{1}}"},
+				{Type: "PHONE_NUMBER", Text: "Call us", PhoneNumber: "38598765432"},
+			},
+			Type: "TEXT",
+		},
+	}
+}
+
+func TestBuildTemplateMsg(t *testing.T) {
+	common := models.MsgCommon{From: "111111111111", To: "222222222222"}
+	values := TemplateValues{
+		Body:    []string{"Ana", "Monday"},
+		Header:  &models.TemplateMsgHeader{Placeholder: "#42"},
+		Buttons: []string{"42"},
+	}
+
+	msg, err := BuildTemplateMsg(testTemplate(), common, values)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.TemplateMsg{
+		MsgCommon: common,
+		Content: models.TemplateMsgContent{
+			TemplateName: "order_shipped",
+			Language:     "en",
+			TemplateData: models.TemplateData{
+				Body:    models.TemplateBody{Placeholders: []string{"Ana", "Monday"}},
+				Header:  &models.TemplateMsgHeader{Type: "TEXT", Placeholder: "#42"},
+				Buttons: []models.TemplateMsgButton{{Type: "URL", Parameter: "42"}},
+			},
+		},
+	}, msg)
+}
+
+func TestBuildTemplateMsgNamedValues(t *testing.T) {
+	template := models.CreateWATemplateResponse{
+		Name:     "welcome",
+		Language: "en",
+		Structure: models.TemplateStructure{
+			Header: &models.TemplateHeader{Format: "IMAGE"},
+			Body:   &models.TemplateStructureBody{Text: "Welcome {{first_name}}, your code is {{code}}."},
+			Buttons: []models.TemplateButton{
+				{Type: "QUICK_REPLY", Text: "Yes"},
+				{Type: "QUICK_REPLY", Text: "No"},
+			},
+			Type: "MEDIA",
+		},
+	}
+	values := TemplateValues{
+		NamedBody: map[string]string{"code": "1234", "first_name": "Ana"},
+		Header:    &models.TemplateMsgHeader{MediaURL: "https://www.example.com/image.png"},
+		Buttons:   []string{"yes-payload", "no-payload"},
+	}
+
+	msg, err := BuildTemplateMsg(template, models.MsgCommon{From: "111111111111", To: "222222222222"}, values)
+
+	require.NoError(t, err)
+	data := msg.Content.TemplateData
+	assert.Equal(t, []string{"Ana", "1234"}, data.Body.Placeholders)
+	assert.Equal(t, &models.TemplateMsgHeader{Type: "IMAGE", MediaURL: "https://www.example.com/image.png"}, data.Header)
+	assert.Equal(t, []models.TemplateMsgButton{
+		{Type: "QUICK_REPLY", Parameter: "yes-payload"},
+		{Type: "QUICK_REPLY", Parameter: "no-payload"},
+	}, data.Buttons)
+}
+
+func TestBuildTemplateMsgWithoutPlaceholders(t *testing.T) {
+	template := models.CreateWATemplateResponse{
+		Name:     "hello_world",
+		Language: "en_US",
+		Structure: models.TemplateStructure{
+			Header: &models.TemplateHeader{Format: "TEXT", Text: "Hello"},
+			Body:   &models.TemplateStructureBody{Text: "Welcome to our service."},
+			Type:   "TEXT",
+		},
+	}
+
+	msg, err := BuildTemplateMsg(template, models.MsgCommon{From: "111111111111", To: "222222222222"}, TemplateValues{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, msg.Content.TemplateData.Body.Placeholders)
+	assert.Nil(t, msg.Content.TemplateData.Header)
+	assert.Empty(t, msg.Content.TemplateData.Buttons)
+}
+
+func TestBuildTemplateMsgMismatches(t *testing.T) {
+	common := models.MsgCommon{From: "111111111111", To: "222222222222"}
+	valid := TemplateValues{
+		Body:    []string{"Ana", "Monday"},
+		Header:  &models.TemplateMsgHeader{Placeholder: "#42"},
+		Buttons: []string{"42"},
+	}
+	tests := []struct {
+		scenario    string
+		modify      func(*models.CreateWATemplateResponse, *TemplateValues)
+		expectedErr error
+	}{
+		{
+			scenario:    "template not approved",
+			modify:      func(tmpl *models.CreateWATemplateResponse, _ *TemplateValues) { tmpl.Status = "PENDING" },
+			expectedErr: ErrTemplateNotApproved,
+		},
+		{
+			scenario:    "missing body value",
+			modify:      func(_ *models.CreateWATemplateResponse, v *TemplateValues) { v.Body = []string{"Ana"} },
+			expectedErr: ErrPlaceholderValues,
+		},
+		{
+			scenario: "missing named body value",
+			modify: func(_ *models.CreateWATemplateResponse, v *TemplateValues) {
+				v.Body = nil
+				v.NamedBody = map[string]string{"1": "Ana", "3": "Monday"}
+			},
+			expectedErr: ErrPlaceholderValues,
+		},
+		{
+			scenario:    "missing header value",
+			modify:      func(_ *models.CreateWATemplateResponse, v *TemplateValues) { v.Header = nil },
+			expectedErr: ErrHeaderValues,
+		},
+		{
+			scenario: "wrong header kind",
+			modify: func(_ *models.CreateWATemplateResponse, v *TemplateValues) {
+				v.Header = &models.TemplateMsgHeader{Type: "IMAGE", MediaURL: "https://www.example.com/image.png"}
+			},
+			expectedErr: ErrHeaderValues,
+		},
+		{
+			scenario: "header values for a template without header",
+			modify: func(tmpl *models.CreateWATemplateResponse, _ *TemplateValues) {
+				tmpl.Structure.Header = nil
+			},
+			expectedErr: ErrHeaderValues,
+		},
+		{
+			scenario:    "missing button value",
+			modify:      func(_ *models.CreateWATemplateResponse, v *TemplateValues) { v.Buttons = nil },
+			expectedErr: ErrButtonValues,
+		},
+		{
+			scenario: "value for a static URL button",
+			modify: func(tmpl *models.CreateWATemplateResponse, _ *TemplateValues) {
+				tmpl.Structure.Buttons[0].URL = "https://www.example.com/track"
+			},
+			expectedErr: ErrButtonValues,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			template := testTemplate()
+			values := valid
+			tc.modify(&template, &values)
+
+			_, err := BuildTemplateMsg(template, common, values)
+			require.ErrorIs(t, err, tc.expectedErr)
+			_, err = PreviewTemplate(template, values)
+			if tc.expectedErr != ErrTemplateNotApproved {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestBuildTemplateMsgValidatesMessage(t *testing.T) {
+	template := testTemplate()
+	values := TemplateValues{
+		Body:    []string{"Ana", ""},
+		Header:  &models.TemplateMsgHeader{Placeholder: "#42"},
+		Buttons: []string{"42"},
+	}
+
+	_, err := BuildTemplateMsg(template, models.MsgCommon{From: "111111111111", To: "222222222222"}, values)
+	require.Error(t, err)
+}
+
+func TestPreviewTemplate(t *testing.T) {
+	values := TemplateValues{
+		Body:    []string{"Ana", "Monday"},
+		Header:  &models.TemplateMsgHeader{Placeholder: "#42"},
+		Buttons: []string{"42"},
+	}
+
+	preview, err := PreviewTemplate(testTemplate(), values)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Order #42\n\n"+
+		"Hi Ana, your order ships on Monday. Thanks, Ana!\n\n"+
+		"Reply STOP to opt out\n\n"+
+		"[Track](https://www.example.com/track/42)\n"+
+		"[Call us](tel:38598765432)", preview)
+}
+
+func TestPreviewTemplateMediaHeader(t *testing.T) {
+	template := models.CreateWATemplateResponse{
+		Structure: models.TemplateStructure{
+			Header:  &models.TemplateHeader{Format: "DOCUMENT"},
+			Body:    &models.TemplateStructureBody{Text: "Your invoice, {{name}}."},
+			Buttons: []models.TemplateButton{{Type: "QUICK_REPLY", Text: "Thanks"}},
+		},
+	}
+	values := TemplateValues{
+		NamedBody: map[string]string{"name": "Ana"},
+		Header:    &models.TemplateMsgHeader{MediaURL: "https://www.example.com/invoice.pdf", Filename: "invoice.pdf"},
+		Buttons:   []string{"thanks"},
+	}
+
+	preview, err := PreviewTemplate(template, values)
+
+	require.NoError(t, err)
+	assert.Equal(t, "[DOCUMENT]\n\nYour invoice, Ana.\n\n[Thanks]", preview)
+}
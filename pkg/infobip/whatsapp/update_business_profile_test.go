@@ -0,0 +1,66 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateBusinessProfileValidReq(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	profile := models.WABusinessProfile{
+		About:    "Always open",
+		Email:    "info@example.com",
+		Websites: []string{"https://www.example.com", "https://shop.example.com"},
+	}
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(businessProfilePath, sender)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+
+		parsedBody, servErr := ioutil.ReadAll(r.Body)
+		assert.NoError(t, servErr)
+		var receivedReq models.WABusinessProfile
+		servErr = json.Unmarshal(parsedBody, &receivedReq)
+		assert.NoError(t, servErr)
+		assert.Equal(t, profile, receivedReq)
+		assert.NotContains(t, string(parsedBody), "address")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	respDetails, err := whatsApp.UpdateBusinessProfile(context.Background(), sender, profile)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestUpdateBusinessProfileInvalidReq(t *testing.T) {
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}}
+	respDetails, err := whatsApp.UpdateBusinessProfile(context.Background(), "111111111111", models.WABusinessProfile{
+		Email: "not an email",
+	})
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
@@ -0,0 +1,72 @@
+package whatsapp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadBusinessProfilePhotoValidReq(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	content := append([]byte("\x89PNG\x0D\x0A\x1A\x0A"), bytes.Repeat([]byte{0}, 1024)...)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(businessProfilePhotoPath, sender)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+
+		file, header, servErr := r.FormFile("photo")
+		require.NoError(t, servErr)
+		assert.Equal(t, "logo.png", header.Filename)
+		assert.Equal(t, "image/png", header.Header.Get("Content-Type"))
+		received, servErr := ioutil.ReadAll(file)
+		assert.NoError(t, servErr)
+		assert.Equal(t, content, received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	photo := models.WABusinessProfilePhoto{Filename: "logo.png", Photo: bytes.NewReader(content)}
+	respDetails, err := whatsApp.UploadBusinessProfilePhoto(context.Background(), sender, photo)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestUploadBusinessProfilePhotoInvalidReq(t *testing.T) {
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}}
+	photo := models.WABusinessProfilePhoto{Filename: "logo.gif", ContentType: "image/gif", Photo: strings.NewReader("")}
+	respDetails, err := whatsApp.UploadBusinessProfilePhoto(context.Background(), "111111111111", photo)
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
+
+func TestUploadBusinessProfilePhotoSniffedInvalidReq(t *testing.T) {
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}}
+	photo := models.WABusinessProfilePhoto{Filename: "logo.gif", Photo: strings.NewReader("GIF89a some gif content")}
+	respDetails, err := whatsApp.UploadBusinessProfilePhoto(context.Background(), "111111111111", photo)
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
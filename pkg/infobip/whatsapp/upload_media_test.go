@@ -0,0 +1,100 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadMediaValidReq(t *testing.T) {
+	apiKey := "some-api-key"
+	sender := "111111111111"
+	content := strings.Repeat("%PDF-1.4 some document ", 1000)
+	rawJSONResp := []byte(`{"url": "https://some.api.infobip.com/whatsapp/1/senders/111111111111/media/some-id"}`)
+	var expectedResp models.UploadWAMediaResponse
+	err := json.Unmarshal(rawJSONResp, &expectedResp)
+	require.NoError(t, err)
+
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(mediaPath, sender)))
+		assert.Equal(t, fmt.Sprint("App ", apiKey), r.Header.Get("Authorization"))
+
+		file, header, servErr := r.FormFile("mediaFile")
+		require.NoError(t, servErr)
+		assert.Equal(t, "document.pdf", header.Filename)
+		assert.Equal(t, "application/pdf", header.Header.Get("Content-Type"))
+		received, servErr := ioutil.ReadAll(file)
+		assert.NoError(t, servErr)
+		assert.Equal(t, content, string(received))
+
+		w.WriteHeader(http.StatusOK)
+		_, servErr = w.Write(rawJSONResp)
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient: http.Client{},
+		BaseURL:    serv.URL,
+		APIKey:     apiKey,
+	}}
+	// A plain io.Reader, which can neither be rewound nor measured.
+	media := io.MultiReader(strings.NewReader(content))
+	upload := models.WAMediaUpload{Filename: "document.pdf", Media: media}
+	resp, respDetails, err := whatsApp.UploadMedia(context.Background(), sender, upload)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResp, resp)
+	assert.Equal(t, http.StatusOK, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, models.ErrorDetails{}, respDetails.ErrorResponse)
+}
+
+func TestUploadMediaInvalidReq(t *testing.T) {
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: "https://example.com"}}
+	_, respDetails, err := whatsApp.UploadMedia(context.Background(), "111111111111", models.WAMediaUpload{
+		Filename: "document.pdf",
+	})
+
+	require.NotNil(t, err)
+	assert.IsType(t, err, validator.ValidationErrors{})
+	assert.Equal(t, models.ResponseDetails{}, respDetails)
+}
+
+func TestUploadMediaErrorIsNotRetried(t *testing.T) {
+	attempts := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		_, servErr := ioutil.ReadAll(r.Body)
+		assert.NoError(t, servErr)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, servErr = w.Write([]byte(`{"requestError": {"serviceException": {"messageId": "UNAVAILABLE"}}}`))
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{
+		HTTPClient:  http.Client{},
+		BaseURL:     serv.URL,
+		RetryPolicy: internal.RetryPolicy{MaxAttempts: 3},
+	}}
+	upload := models.WAMediaUpload{Filename: "image.png", ContentType: "image/png", Media: strings.NewReader("png")}
+	_, respDetails, err := whatsApp.UploadMedia(context.Background(), "111111111111", upload)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, respDetails.HTTPResponse.StatusCode)
+	assert.Equal(t, "UNAVAILABLE", respDetails.ErrorResponse.RequestError.ServiceException.MessageID)
+}
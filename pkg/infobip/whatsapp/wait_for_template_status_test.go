@@ -0,0 +1,125 @@
+package whatsapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
+	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func templatesServer(t *testing.T, sender string, statuses ...string) (*httptest.Server, *int) {
+	t.Helper()
+	polls := 0
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.True(t, strings.HasSuffix(r.URL.Path, fmt.Sprintf(templatesPath, sender)))
+		status := statuses[polls]
+		if polls < len(statuses)-1 {
+			polls++
+		}
+		w.WriteHeader(http.StatusOK)
+		_, servErr := fmt.Fprintf(w, `{"templates": [
+			{"ID": "222", "name": "other_template", "status": "APPROVED"},
+			{"ID": "111", "name": "some_template", "status": "%s", "rejectionReason": "%s"}
+		]}`, status, map[bool]string{true: "INVALID_FORMAT"}[status == models.TemplateStatusRejected])
+		assert.NoError(t, servErr)
+	}))
+
+	return serv, &polls
+}
+
+func TestWaitForTemplateStatus(t *testing.T) {
+	tests := []struct {
+		scenario       string
+		statuses       []string
+		expectedStatus string
+		expectedReason string
+		expectedPolls  int
+	}{
+		{
+			scenario:       "approved",
+			statuses:       []string{"PENDING", "PENDING", "APPROVED"},
+			expectedStatus: models.TemplateStatusApproved,
+			expectedPolls:  2,
+		},
+		{
+			scenario:       "rejected",
+			statuses:       []string{"PENDING", "REJECTED"},
+			expectedStatus: models.TemplateStatusRejected,
+			expectedReason: "INVALID_FORMAT",
+			expectedPolls:  1,
+		},
+		{
+			scenario:       "already reviewed",
+			statuses:       []string{"APPROVED"},
+			expectedStatus: models.TemplateStatusApproved,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			serv, polls := templatesServer(t, "111111111111", tc.statuses...)
+			defer serv.Close()
+
+			whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+			template, err := whatsApp.WaitForTemplateStatus(
+				context.Background(), "111111111111", "111", time.Millisecond)
+
+			require.NoError(t, err)
+			assert.Equal(t, "111", template.ID)
+			assert.Equal(t, tc.expectedStatus, template.Status)
+			assert.Equal(t, tc.expectedReason, template.RejectionReason)
+			assert.Equal(t, tc.expectedPolls, *polls)
+		})
+	}
+}
+
+func TestWaitForTemplateStatusContextDone(t *testing.T) {
+	serv, _ := templatesServer(t, "111111111111", "PENDING")
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	template, err := whatsApp.WaitForTemplateStatus(ctx, "111111111111", "111", time.Millisecond)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, models.TemplateStatusPending, template.Status)
+}
+
+func TestWaitForTemplateStatusNotFound(t *testing.T) {
+	serv, _ := templatesServer(t, "111111111111", "PENDING")
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+	_, err := whatsApp.WaitForTemplateStatus(context.Background(), "111111111111", "333", time.Millisecond)
+
+	require.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestWaitForTemplateStatusUnsuccessfulResponse(t *testing.T) {
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, servErr := w.Write([]byte(`{"requestError": {"serviceException": {"messageId": "UNAUTHORIZED"}}}`))
+		assert.NoError(t, servErr)
+	}))
+	defer serv.Close()
+
+	whatsApp := Channel{ReqHandler: internal.HTTPHandler{HTTPClient: http.Client{}, BaseURL: serv.URL}}
+	_, err := whatsApp.WaitForTemplateStatus(context.Background(), "111111111111", "111", time.Millisecond)
+
+	var apiErr *internal.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, "UNAUTHORIZED", apiErr.MessageID)
+	assert.ErrorIs(t, err, internal.ErrUnauthorized)
+}
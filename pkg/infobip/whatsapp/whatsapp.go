@@ -2,7 +2,13 @@ package whatsapp
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/infobip-community/infobip-api-go-sdk/v3/internal"
 	"github.com/infobip-community/infobip-api-go-sdk/v3/pkg/infobip/models"
@@ -28,11 +34,46 @@ type WhatsApp interface {
 	) (models.SendWAMsgResponse, models.ResponseDetails, error)
 	SendInteractiveMultiproduct(context.Context, models.WAInteractiveMultiproductMsg,
 	) (models.SendWAMsgResponse, models.ResponseDetails, error)
+	SendInteractiveFlow(context.Context, models.WAInteractiveFlowMsg,
+	) (models.SendWAMsgResponse, models.ResponseDetails, error)
+	SendInteractiveURLButton(context.Context, models.WAInteractiveURLButtonMsg,
+	) (models.SendWAMsgResponse, models.ResponseDetails, error)
+	SendInteractiveLocationRequest(context.Context, models.WAInteractiveLocationRequestMsg,
+	) (models.SendWAMsgResponse, models.ResponseDetails, error)
+	SendInteractiveOrderDetails(context.Context, models.WAInteractiveOrderDetailsMsg,
+	) (models.SendWAMsgResponse, models.ResponseDetails, error)
+	SendInteractiveOrderStatus(context.Context, models.WAInteractiveOrderStatusMsg,
+	) (models.SendWAMsgResponse, models.ResponseDetails, error)
+	SendBulk(context.Context, []models.Validatable, BulkOptions) BulkResponse
+	GetPaymentStatus(context.Context, string, string) (models.WAPaymentStatus, models.ResponseDetails, error)
 	GetTemplates(context.Context, string) (models.GetWATemplatesResponse, models.ResponseDetails, error)
 	CreateTemplate(context.Context, string, models.TemplateCreate,
 	) (models.CreateWATemplateResponse, models.ResponseDetails, error)
 	DeleteTemplate(context.Context, string, string,
 	) (models.ResponseDetails, error)
+	GetTemplate(context.Context, string, string) (models.CreateWATemplateResponse, models.ResponseDetails, error)
+	EditTemplate(context.Context, string, string, models.TemplateEdit,
+	) (models.CreateWATemplateResponse, models.ResponseDetails, error)
+	WaitForTemplateStatus(context.Context, string, string, time.Duration) (models.CreateWATemplateResponse, error)
+	UploadMedia(context.Context, string, models.WAMediaUpload,
+	) (models.UploadWAMediaResponse, models.ResponseDetails, error)
+	DownloadMedia(context.Context, string, string, io.Writer) (models.ResponseDetails, error)
+	DownloadMediaByURL(context.Context, string, io.Writer) (models.ResponseDetails, error)
+	GetMediaMetadata(context.Context, string, string) (models.WAMediaMetadata, models.ResponseDetails, error)
+	DeleteMedia(context.Context, string, models.DeleteWAMediaRequest) (models.ResponseDetails, error)
+	ListSenders(context.Context) (models.GetWASendersResponse, models.ResponseDetails, error)
+	GetSenderQuality(context.Context, string) (models.WASenderQuality, models.ResponseDetails, error)
+	GetBusinessProfile(context.Context, string) (models.WABusinessProfile, models.ResponseDetails, error)
+	UpdateBusinessProfile(context.Context, string, models.WABusinessProfile) (models.ResponseDetails, error)
+	UploadBusinessProfilePhoto(context.Context, string, models.WABusinessProfilePhoto) (models.ResponseDetails, error)
+	RegisterSender(context.Context, models.RegisterWASenderRequest,
+	) (models.WASender, models.ResponseDetails, error)
+	VerifySender(context.Context, string, models.VerifyWASenderRequest,
+	) (models.WASender, models.ResponseDetails, error)
+	MarkAsRead(context.Context, string, string) (models.ResponseDetails, error)
+	GetIdentity(context.Context, string, string) (models.WAIdentity, models.ResponseDetails, error)
+	ConfirmIdentity(context.Context, string, string, models.ConfirmWAIdentityRequest,
+	) (models.ResponseDetails, error)
 }
 
 type Channel struct {
@@ -53,14 +94,42 @@ const (
 	sendInteractiveListPath         = "whatsapp/1/message/interactive/list"
 	sendInteractiveProductPath      = "whatsapp/1/message/interactive/product"
 	sendInteractiveMultiproductPath = "whatsapp/1/message/interactive/multi-product"
+	sendInteractiveFlowPath         = "whatsapp/1/message/interactive/flow"
+	sendInteractiveURLButtonPath    = "whatsapp/1/message/interactive/url-button"
+	sendInteractiveLocationReqPath  = "whatsapp/1/message/interactive/location-request"
+	sendInteractiveOrderDetailsPath = "whatsapp/1/message/interactive/order-details"
+	sendInteractiveOrderStatusPath  = "whatsapp/1/message/interactive/order-status"
+	paymentPath                     = "whatsapp/1/senders/%s/payments/%s"
 	templatesPath                   = "whatsapp/2/senders/%s/templates"
-	deleteTemplatePath              = "whatsapp/2/senders/%s/templates/%s"
+	templatePath                    = "whatsapp/2/senders/%s/templates/%s"
+	mediaPath                       = "whatsapp/1/senders/%s/media"
+	mediaFilePath                   = "whatsapp/1/senders/%s/media/%s"
+	markAsReadPath                  = "whatsapp/1/senders/%s/message/%s/read"
+	sendersPath                     = "whatsapp/2/senders"
+	senderQualityPath               = "whatsapp/2/senders/%s/quality"
+	businessProfilePath             = "whatsapp/2/senders/%s/business-profile"
+	businessProfilePhotoPath        = "whatsapp/2/senders/%s/business-profile/photo"
+	senderVerificationPath          = "whatsapp/2/senders/%s/verification"
+	identityPath                    = "whatsapp/1/%s/contacts/%s/identity"
 )
 
+// DefaultTemplatePollInterval is the interval at which WaitForTemplateStatus polls templates when no positive
+// interval is given.
+const DefaultTemplatePollInterval = 30 * time.Second
+
+// ErrTemplateNotFound is returned by WaitForTemplateStatus when the sender has no template with the given ID.
+var ErrTemplateNotFound = errors.New("template not found")
+
+// ErrForeignMediaURL is returned when downloading media from a URL which does not belong to the client's base
+// URL, so that the client's credentials are never sent elsewhere.
+var ErrForeignMediaURL = errors.New("media URL does not match the base URL")
+
 func (wap *Channel) SendTemplate(
 	ctx context.Context,
 	messages models.WATemplateMsgs,
 ) (msgResp models.BulkWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendTemplate", sendTemplateMessagesPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &messages, &msgResp, sendTemplateMessagesPath)
 	return msgResp, respDetails, err
 }
@@ -69,6 +138,8 @@ func (wap *Channel) SendText(
 	ctx context.Context,
 	msg models.WATextMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendText", sendMessagePath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendMessagePath)
 	return msgResp, respDetails, err
 }
@@ -77,6 +148,8 @@ func (wap *Channel) SendDocument(
 	ctx context.Context,
 	msg models.WADocumentMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendDocument", sendDocumentPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendDocumentPath)
 	return msgResp, respDetails, err
 }
@@ -85,6 +158,8 @@ func (wap *Channel) SendImage(
 	ctx context.Context,
 	msg models.WAImageMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendImage", sendImagePath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendImagePath)
 	return msgResp, respDetails, err
 }
@@ -93,6 +168,8 @@ func (wap *Channel) SendAudio(
 	ctx context.Context,
 	msg models.WAAudioMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendAudio", sendAudioPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendAudioPath)
 	return msgResp, respDetails, err
 }
@@ -101,6 +178,8 @@ func (wap *Channel) SendVideo(
 	ctx context.Context,
 	msg models.WAVideoMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendVideo", sendVideoPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendVideoPath)
 	return msgResp, respDetails, err
 }
@@ -109,6 +188,8 @@ func (wap *Channel) SendSticker(
 	ctx context.Context,
 	msg models.WAStickerMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendSticker", sendStickerPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendStickerPath)
 	return msgResp, respDetails, err
 }
@@ -117,6 +198,8 @@ func (wap *Channel) SendLocation(
 	ctx context.Context,
 	msg models.WALocationMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendLocation", sendLocationPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendLocationPath)
 	return msgResp, respDetails, err
 }
@@ -125,6 +208,8 @@ func (wap *Channel) SendContact(
 	ctx context.Context,
 	msg models.WAContactMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendContact", sendContactPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendContactPath)
 	return msgResp, respDetails, err
 }
@@ -133,6 +218,8 @@ func (wap *Channel) SendInteractiveButtons(
 	ctx context.Context,
 	msg models.WAInteractiveButtonsMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendInteractiveButtons", sendInteractiveButtonsPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendInteractiveButtonsPath)
 	return msgResp, respDetails, err
 }
@@ -141,6 +228,8 @@ func (wap *Channel) SendInteractiveList(
 	ctx context.Context,
 	msg models.WAInteractiveListMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendInteractiveList", sendInteractiveListPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendInteractiveListPath)
 	return msgResp, respDetails, err
 }
@@ -149,6 +238,8 @@ func (wap *Channel) SendInteractiveProduct(
 	ctx context.Context,
 	msg models.WAInteractiveProductMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendInteractiveProduct", sendInteractiveProductPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendInteractiveProductPath)
 	return msgResp, respDetails, err
 }
@@ -157,14 +248,80 @@ func (wap *Channel) SendInteractiveMultiproduct(
 	ctx context.Context,
 	msg models.WAInteractiveMultiproductMsg,
 ) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendInteractiveMultiproduct", sendInteractiveMultiproductPath)
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendInteractiveMultiproductPath)
 	return msgResp, respDetails, err
 }
 
+func (wap *Channel) SendInteractiveFlow(
+	ctx context.Context,
+	msg models.WAInteractiveFlowMsg,
+) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendInteractiveFlow", sendInteractiveFlowPath)
+
+	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendInteractiveFlowPath)
+	return msgResp, respDetails, err
+}
+
+func (wap *Channel) SendInteractiveURLButton(
+	ctx context.Context,
+	msg models.WAInteractiveURLButtonMsg,
+) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendInteractiveURLButton", sendInteractiveURLButtonPath)
+
+	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendInteractiveURLButtonPath)
+	return msgResp, respDetails, err
+}
+
+func (wap *Channel) SendInteractiveLocationRequest(
+	ctx context.Context,
+	msg models.WAInteractiveLocationRequestMsg,
+) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendInteractiveLocationRequest", sendInteractiveLocationReqPath)
+
+	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendInteractiveLocationReqPath)
+	return msgResp, respDetails, err
+}
+
+func (wap *Channel) SendInteractiveOrderDetails(
+	ctx context.Context,
+	msg models.WAInteractiveOrderDetailsMsg,
+) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendInteractiveOrderDetails", sendInteractiveOrderDetailsPath)
+
+	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendInteractiveOrderDetailsPath)
+	return msgResp, respDetails, err
+}
+
+func (wap *Channel) SendInteractiveOrderStatus(
+	ctx context.Context,
+	msg models.WAInteractiveOrderStatusMsg,
+) (msgResp models.SendWAMsgResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.SendInteractiveOrderStatus", sendInteractiveOrderStatusPath)
+
+	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &msg, &msgResp, sendInteractiveOrderStatusPath)
+	return msgResp, respDetails, err
+}
+
+// GetPaymentStatus returns the status of the payment of the order sent with the given reference ID.
+func (wap *Channel) GetPaymentStatus(
+	ctx context.Context,
+	sender string,
+	referenceID string,
+) (resp models.WAPaymentStatus, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.GetPaymentStatus", "whatsapp/1/senders/{sender}/payments/{referenceId}")
+
+	respDetails, err = wap.ReqHandler.GetRequest(ctx, &resp, fmt.Sprintf(paymentPath, sender, referenceID), nil)
+	return resp, respDetails, err
+}
+
 func (wap *Channel) GetTemplates(
 	ctx context.Context,
 	sender string,
 ) (resp models.GetWATemplatesResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.GetTemplates", "whatsapp/2/senders/{sender}/templates")
+
 	respDetails, err = wap.ReqHandler.GetRequest(ctx, &resp, fmt.Sprintf(templatesPath, sender), nil)
 	return resp, respDetails, err
 }
@@ -174,6 +331,8 @@ func (wap *Channel) CreateTemplate(
 	sender string,
 	template models.TemplateCreate,
 ) (resp models.CreateWATemplateResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.CreateTemplate", "whatsapp/2/senders/{sender}/templates")
+
 	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &template, &resp, fmt.Sprintf(templatesPath, sender))
 	return resp, respDetails, err
 }
@@ -183,6 +342,298 @@ func (wap *Channel) DeleteTemplate(
 	sender string,
 	templateName string,
 ) (respDetails models.ResponseDetails, err error) {
-	respDetails, err = wap.ReqHandler.DeleteRequest(ctx, fmt.Sprintf(deleteTemplatePath, sender, templateName), nil)
+	ctx = internal.WithOperation(ctx, "WhatsApp.DeleteTemplate", "whatsapp/2/senders/{sender}/templates/{templateName}")
+
+	respDetails, err = wap.ReqHandler.DeleteRequest(ctx, fmt.Sprintf(templatePath, sender, templateName), nil)
+	return respDetails, err
+}
+
+// GetTemplate returns a single template of a sender, by its ID.
+func (wap *Channel) GetTemplate(
+	ctx context.Context,
+	sender string,
+	id string,
+) (resp models.CreateWATemplateResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.GetTemplate", "whatsapp/2/senders/{sender}/templates/{id}")
+
+	respDetails, err = wap.ReqHandler.GetRequest(ctx, &resp, fmt.Sprintf(templatePath, sender, id), nil)
+	return resp, respDetails, err
+}
+
+// EditTemplate updates the category or structure of a template. Edited templates go through approval again.
+func (wap *Channel) EditTemplate(
+	ctx context.Context,
+	sender string,
+	id string,
+	template models.TemplateEdit,
+) (resp models.CreateWATemplateResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.EditTemplate", "whatsapp/2/senders/{sender}/templates/{id}")
+
+	respDetails, err = wap.ReqHandler.PatchJSONReq(ctx, &template, &resp, fmt.Sprintf(templatePath, sender, id))
+	return resp, respDetails, err
+}
+
+// WaitForTemplateStatus polls the templates of a sender until the template with the given ID is no longer
+// PENDING, and returns it. The RejectionReason of the returned template explains a REJECTED status.
+// Unsuccessful responses are returned as an *infobip.APIError. Errors, including the context error when ctx is
+// done before the template is reviewed, are returned along with the last known state of the template.
+func (wap *Channel) WaitForTemplateStatus(
+	ctx context.Context,
+	sender string,
+	id string,
+	pollInterval time.Duration,
+) (models.CreateWATemplateResponse, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultTemplatePollInterval
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var last models.CreateWATemplateResponse
+	for {
+		resp, respDetails, err := wap.GetTemplates(ctx, sender)
+		if err != nil {
+			return last, err
+		}
+		if err = internal.ResponseError(respDetails); err != nil {
+			return last, err
+		}
+
+		template, found := findTemplate(resp.Templates, id)
+		if !found {
+			return last, ErrTemplateNotFound
+		}
+		if template.Status != models.TemplateStatusPending {
+			return template, nil
+		}
+		last = template
+
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func findTemplate(templates []models.CreateWATemplateResponse, id string) (models.CreateWATemplateResponse, bool) {
+	for _, template := range templates {
+		if template.ID == id {
+			return template, true
+		}
+	}
+
+	return models.CreateWATemplateResponse{}, false
+}
+
+// UploadMedia uploads a media file to a sender, streaming it from upload.Media. The returned URL can be used as
+// the MediaURL of messages sent by the sender.
+func (wap *Channel) UploadMedia(
+	ctx context.Context,
+	sender string,
+	upload models.WAMediaUpload,
+) (resp models.UploadWAMediaResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.UploadMedia", "whatsapp/1/senders/{sender}/media")
+
+	respDetails, err = wap.ReqHandler.PostMultipartStreamReq(ctx, &upload, &resp, fmt.Sprintf(mediaPath, sender))
+	return resp, respDetails, err
+}
+
+// DownloadMedia copies the content of a media file, such as one received in an inbound message, into w.
+func (wap *Channel) DownloadMedia(
+	ctx context.Context,
+	sender string,
+	mediaID string,
+	w io.Writer,
+) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.DownloadMedia", "whatsapp/1/senders/{sender}/media/{mediaId}")
+
+	respDetails, err = wap.ReqHandler.DownloadReq(ctx, w, fmt.Sprintf(mediaFilePath, sender, mediaID))
+	return respDetails, err
+}
+
+// DownloadMediaByURL copies the content of a media file into w, given its URL, such as the URL of inbound media
+// messages. The URL must belong to the client's base URL, otherwise ErrForeignMediaURL is returned.
+func (wap *Channel) DownloadMediaByURL(
+	ctx context.Context,
+	mediaURL string,
+	w io.Writer,
+) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.DownloadMediaByURL", "{mediaUrl}")
+
+	reqPath, err := wap.relativePath(mediaURL)
+	if err != nil {
+		return respDetails, err
+	}
+	respDetails, err = wap.ReqHandler.DownloadReq(ctx, w, reqPath)
+	return respDetails, err
+}
+
+// GetMediaMetadata returns the content type and size of a media file, without downloading it.
+func (wap *Channel) GetMediaMetadata(
+	ctx context.Context,
+	sender string,
+	mediaID string,
+) (resp models.WAMediaMetadata, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.GetMediaMetadata", "whatsapp/1/senders/{sender}/media/{mediaId}")
+
+	respDetails, err = wap.ReqHandler.HeadRequest(ctx, fmt.Sprintf(mediaFilePath, sender, mediaID))
+	if err == nil && respDetails.HTTPResponse.StatusCode == http.StatusOK {
+		resp.ContentType = respDetails.HTTPResponse.Header.Get("Content-Type")
+		resp.ContentLength = respDetails.HTTPResponse.ContentLength
+	}
+	return resp, respDetails, err
+}
+
+// DeleteMedia deletes a media file previously uploaded to a sender.
+func (wap *Channel) DeleteMedia(
+	ctx context.Context,
+	sender string,
+	req models.DeleteWAMediaRequest,
+) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.DeleteMedia", "whatsapp/1/senders/{sender}/media")
+
+	respDetails, err = wap.ReqHandler.DeleteJSONReq(ctx, &req, fmt.Sprintf(mediaPath, sender))
+	return respDetails, err
+}
+
+// ListSenders returns the senders of the account, along with their status, quality rating and messaging limit.
+func (wap *Channel) ListSenders(
+	ctx context.Context,
+) (resp models.GetWASendersResponse, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.ListSenders", sendersPath)
+
+	respDetails, err = wap.ReqHandler.GetRequest(ctx, &resp, sendersPath, nil)
+	return resp, respDetails, err
+}
+
+// GetSenderQuality returns the quality rating and messaging limit tier of a sender.
+func (wap *Channel) GetSenderQuality(
+	ctx context.Context,
+	sender string,
+) (resp models.WASenderQuality, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.GetSenderQuality", "whatsapp/2/senders/{sender}/quality")
+
+	respDetails, err = wap.ReqHandler.GetRequest(ctx, &resp, fmt.Sprintf(senderQualityPath, sender), nil)
+	return resp, respDetails, err
+}
+
+func (wap *Channel) GetBusinessProfile(
+	ctx context.Context,
+	sender string,
+) (resp models.WABusinessProfile, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.GetBusinessProfile", "whatsapp/2/senders/{sender}/business-profile")
+
+	respDetails, err = wap.ReqHandler.GetRequest(ctx, &resp, fmt.Sprintf(businessProfilePath, sender), nil)
+	return resp, respDetails, err
+}
+
+// UpdateBusinessProfile updates the fields of the business profile of a sender which are set in profile.
+func (wap *Channel) UpdateBusinessProfile(
+	ctx context.Context,
+	sender string,
+	profile models.WABusinessProfile,
+) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.UpdateBusinessProfile", "whatsapp/2/senders/{sender}/business-profile")
+
+	respDetails, err = wap.ReqHandler.PatchJSONReq(ctx, &profile, nil, fmt.Sprintf(businessProfilePath, sender))
+	return respDetails, err
+}
+
+// UploadBusinessProfilePhoto replaces the profile photo of a sender, streaming it from photo.Photo.
+func (wap *Channel) UploadBusinessProfilePhoto(
+	ctx context.Context,
+	sender string,
+	photo models.WABusinessProfilePhoto,
+) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.UploadBusinessProfilePhoto",
+		"whatsapp/2/senders/{sender}/business-profile/photo")
+
+	respDetails, err = wap.ReqHandler.PostMultipartStreamReq(
+		ctx, &photo, nil, fmt.Sprintf(businessProfilePhotoPath, sender))
+	return respDetails, err
+}
+
+// RegisterSender starts the registration of a new sender, which is completed by VerifySender.
+func (wap *Channel) RegisterSender(
+	ctx context.Context,
+	req models.RegisterWASenderRequest,
+) (resp models.WASender, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.RegisterSender", sendersPath)
+
+	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &req, &resp, sendersPath)
+	return resp, respDetails, err
+}
+
+// VerifySender completes the registration of a sender with the code sent to its phone number.
+func (wap *Channel) VerifySender(
+	ctx context.Context,
+	sender string,
+	req models.VerifyWASenderRequest,
+) (resp models.WASender, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.VerifySender", "whatsapp/2/senders/{sender}/verification")
+
+	respDetails, err = wap.ReqHandler.PostJSONReq(ctx, &req, &resp, fmt.Sprintf(senderVerificationPath, sender))
+	return resp, respDetails, err
+}
+
+// MarkAsRead marks an inbound message, and all the messages received before it, as read.
+func (wap *Channel) MarkAsRead(
+	ctx context.Context,
+	sender string,
+	messageID string,
+) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.MarkAsRead", "whatsapp/1/senders/{sender}/message/{messageId}/read")
+
+	respDetails, err = wap.ReqHandler.PostNoContentReq(ctx, fmt.Sprintf(markAsReadPath, sender, messageID))
 	return respDetails, err
 }
+
+// GetIdentity returns the identity of a user, along with whether its last change has been acknowledged.
+func (wap *Channel) GetIdentity(
+	ctx context.Context,
+	sender string,
+	userNumber string,
+) (resp models.WAIdentity, respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.GetIdentity", "whatsapp/1/{sender}/contacts/{userNumber}/identity")
+
+	respDetails, err = wap.ReqHandler.GetRequest(ctx, &resp, fmt.Sprintf(identityPath, sender, userNumber), nil)
+	return resp, respDetails, err
+}
+
+// ConfirmIdentity acknowledges an identity change of a user, so that messages can be delivered to them again.
+func (wap *Channel) ConfirmIdentity(
+	ctx context.Context,
+	sender string,
+	userNumber string,
+	req models.ConfirmWAIdentityRequest,
+) (respDetails models.ResponseDetails, err error) {
+	ctx = internal.WithOperation(ctx, "WhatsApp.ConfirmIdentity", "whatsapp/1/{sender}/contacts/{userNumber}/identity")
+
+	respDetails, err = wap.ReqHandler.PutJSONNoContentReq(ctx, &req, fmt.Sprintf(identityPath, sender, userNumber))
+	return respDetails, err
+}
+
+// relativePath returns the path of an absolute URL relative to the client's base URL, keeping its query, which
+// may hold a signature or a token.
+func (wap *Channel) relativePath(absoluteURL string) (string, error) {
+	target, err := url.Parse(absoluteURL)
+	if err != nil {
+		return "", err
+	}
+	base, err := url.Parse(wap.ReqHandler.BaseURL)
+	if err != nil {
+		return "", err
+	}
+	basePath := strings.TrimSuffix(base.EscapedPath(), "/") + "/"
+	if target.Scheme != base.Scheme || target.Host != base.Host || !strings.HasPrefix(target.EscapedPath(), basePath) {
+		return "", ErrForeignMediaURL
+	}
+
+	reqPath := strings.TrimPrefix(target.EscapedPath(), basePath)
+	if target.RawQuery != "" {
+		reqPath += "?" + target.RawQuery
+	}
+
+	return reqPath, nil
+}